@@ -5,11 +5,14 @@ go 1.25.0
 tool github.com/dmarkham/enumer
 
 require (
+	github.com/coder/websocket v1.8.14
 	github.com/go-playground/validator/v10 v10.30.2
 	github.com/jaxron/axonet v0.0.0-20260322084616-291a42f8fe4b
 	github.com/jaxron/axonet/middleware/proxy v0.0.0-20260322084616-291a42f8fe4b
 	github.com/jaxron/axonet/middleware/retry v0.0.0-20260322084616-291a42f8fe4b
 	github.com/stretchr/testify v1.9.0
+	golang.org/x/image v0.37.0
+	golang.org/x/sync v0.20.0
 )
 
 require (
@@ -26,8 +29,6 @@ require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	golang.org/x/crypto v0.49.0 // indirect
 	golang.org/x/mod v0.33.0 // indirect
-	golang.org/x/net v0.51.0 // indirect
-	golang.org/x/sync v0.20.0 // indirect
 	golang.org/x/sys v0.42.0 // indirect
 	golang.org/x/text v0.35.0 // indirect
 	golang.org/x/tools v0.42.0 // indirect
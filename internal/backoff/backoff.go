@@ -0,0 +1,58 @@
+// Package backoff computes the delays of polling and reconnect loops.
+package backoff
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff tracks a delay that doubles on failure, between a shortest and a longest delay.
+// It is not safe for concurrent use.
+type Backoff struct {
+	minDelay time.Duration
+	maxDelay time.Duration
+	jitter   float64
+	delay    time.Duration
+}
+
+// New creates a new Backoff that starts at minDelay and never exceeds maxDelay.
+// Each delay is shortened at random by up to the jitter fraction, between 0 and 1.
+func New(minDelay, maxDelay time.Duration, jitter float64) *Backoff {
+	return &Backoff{
+		minDelay: minDelay,
+		maxDelay: maxDelay,
+		jitter:   jitter,
+		delay:    minDelay,
+	}
+}
+
+// Delay returns the current delay with jitter applied.
+func (b *Backoff) Delay() time.Duration {
+	return Jitter(b.delay, b.jitter)
+}
+
+// Increase doubles the delay, up to the longest delay.
+func (b *Backoff) Increase() {
+	b.delay = min(b.delay*2, b.maxDelay)
+}
+
+// Decrease shrinks the delay by a quarter, down to the shortest delay, so that it
+// recovers gradually after failures stop.
+func (b *Backoff) Decrease() {
+	b.delay = max(b.delay*3/4, b.minDelay)
+}
+
+// Reset starts over from the shortest delay.
+func (b *Backoff) Reset() {
+	b.delay = b.minDelay
+}
+
+// Jitter shortens a delay at random by up to the given fraction, so that loops started
+// together drift apart. A fraction of 0 returns the delay unchanged.
+func Jitter(delay time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 {
+		return delay
+	}
+
+	return delay - time.Duration(rand.Float64()*fraction*float64(delay)) //nolint:gosec // Jitter does not need a secure source
+}
@@ -0,0 +1,38 @@
+package backoff_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jaxron/roapi.go/internal/backoff"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff(t *testing.T) {
+	b := backoff.New(time.Second, 5*time.Second, 0)
+	assert.Equal(t, time.Second, b.Delay())
+
+	b.Increase()
+	assert.Equal(t, 2*time.Second, b.Delay())
+
+	b.Increase()
+	b.Increase()
+	assert.Equal(t, 5*time.Second, b.Delay())
+
+	b.Decrease()
+	assert.Equal(t, 3750*time.Millisecond, b.Delay())
+
+	b.Reset()
+	b.Decrease()
+	assert.Equal(t, time.Second, b.Delay())
+}
+
+func TestJitter(t *testing.T) {
+	assert.Equal(t, time.Second, backoff.Jitter(time.Second, 0))
+
+	for range 100 {
+		delay := backoff.Jitter(time.Second, 0.5)
+		assert.GreaterOrEqual(t, delay, 500*time.Millisecond)
+		assert.LessOrEqual(t, delay, time.Second)
+	}
+}
@@ -0,0 +1,84 @@
+// Package csrf sends POST requests that need a CSRF token the server hands out on rejection.
+package csrf
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+)
+
+// Token holds a CSRF token that is safe for concurrent use. The zero value holds no token.
+type Token struct {
+	value string
+	mu    sync.RWMutex
+}
+
+// Get returns the current token, or an empty string if there is none.
+func (t *Token) Get() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.value
+}
+
+// Set replaces the current token.
+func (t *Token) Set(value string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.value = value
+}
+
+// Post sends a JSON request with the given token. If the token is missing or expired, the
+// server rejects the request with a new token, which is stored and the request is sent once
+// more with it. The result is decoded into result unless it is nil.
+//
+// Once the token is set, it is sent in place of any token the auth middleware would add.
+func Post(ctx context.Context, c *client.Client, token *Token, url string, body, result any) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		reqCtx := ctx
+
+		req := c.NewRequest().
+			Method(http.MethodPost).
+			URL(url).
+			MarshalBody(body)
+		if value := token.Get(); value != "" {
+			req.Header("X-Csrf-Token", value)
+			reqCtx = context.WithValue(ctx, auth.KeyAddToken, false)
+		}
+
+		if result != nil {
+			req.Result(result)
+		}
+
+		resp, err := req.Do(reqCtx)
+		if resp == nil {
+			return nil, err
+		}
+
+		// Retry once with the token the server handed out
+		if newToken := resp.Header.Get("X-Csrf-Token"); resp.StatusCode == http.StatusForbidden && newToken != "" && attempt == 0 {
+			_ = resp.Body.Close()
+			token.Set(newToken)
+
+			continue
+		}
+
+		// Status codes are only turned into errors by the retry middleware, so check them directly
+		if resp.StatusCode >= http.StatusBadRequest {
+			defer func() { _ = resp.Body.Close() }()
+			return nil, errs.New(resp)
+		}
+
+		if err != nil {
+			defer func() { _ = resp.Body.Close() }()
+			return nil, errs.HandleAPIError(resp, err)
+		}
+
+		return resp, nil
+	}
+}
@@ -1,10 +1,13 @@
 package utils
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"net"
+	"net/http"
 	"net/url"
 	"os"
 	"strings"
@@ -14,16 +17,23 @@ import (
 	"github.com/jaxron/axonet/middleware/retry"
 	"github.com/jaxron/axonet/pkg/client"
 	"github.com/jaxron/axonet/pkg/client/logger"
+	"github.com/jaxron/axonet/pkg/client/middleware"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/apikey"
 	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
 	"github.com/jaxron/roapi.go/pkg/api/middleware/jsonheader"
+	"github.com/jaxron/roapi.go/pkg/api/validation"
 )
 
 var (
 	ErrInvalidProxyFormat = errors.New("invalid proxy format, expected IP:Port:Username:Password")
 	ErrProxyNotSet        = errors.New("ROAPI_PROXY environment variable not set")
 	ErrCookieNotSet       = errors.New("ROAPI_COOKIE environment variable not set")
+	ErrAPIKeyNotSet       = errors.New("ROAPI_API_KEY environment variable not set")
 )
 
+// LocalTestAPIKey is the API key requests made by NewLocalTestEnv carry.
+const LocalTestAPIKey = "local-test-api-key"
+
 // ExpectedProxyParts is the number of parts expected in a proxy string (IP:Port:Username:Password).
 const ExpectedProxyParts = 4
 
@@ -59,9 +69,14 @@ const (
 	SampleOutfitID  = int64(13993719293)
 	InvalidOutfitID = int64(math.MaxInt64)
 
-	SampleAssetID  = int64(3360686498)
-	SampleAssetID2 = int64(48474356)
-	InvalidAssetID = int64(0)
+	SampleAssetID        = int64(3360686498)
+	SampleAssetID2       = int64(48474356)
+	SampleLimitedAssetID = int64(1365767) // Valkyrie Helm
+	InvalidAssetID       = int64(0)
+
+	SampleBundleID  = int64(192) // Korblox Deathspeaker
+	SampleBundleID2 = int64(201) // Headless Horseman
+	InvalidBundleID = int64(0)
 )
 
 // NewTestEnv creates a new client.Client instance and a validator.Validate for testing purposes.
@@ -91,9 +106,89 @@ func NewTestEnv(opts ...client.Option) (*client.Client, *validator.Validate) {
 		}, opts...)...,
 	)
 
-	return httpClient, validator.New(validator.WithRequiredStructEnabled())
+	return httpClient, validation.New()
+}
+
+// NewCloudTestEnv creates a new client.Client instance for Open Cloud and a validator.Validate for testing purposes.
+// It reads proxy and API key values directly from environment variables.
+func NewCloudTestEnv(opts ...client.Option) (*client.Client, *validator.Validate) {
+	basicLogger := logger.NewBasicLogger()
+
+	proxyURL, err := parseProxy(os.Getenv("ROAPI_PROXY"))
+	if err != nil {
+		panic(err)
+	}
+
+	key := os.Getenv("ROAPI_API_KEY")
+	if key == "" {
+		panic(ErrAPIKeyNotSet)
+	}
+
+	apiKeyMiddleware := apikey.New([]string{key})
+	proxyMiddleware := proxy.New([]*url.URL{proxyURL})
+	httpClient := client.NewClient(
+		append([]client.Option{
+			client.WithLogger(basicLogger),
+			client.WithMiddleware(retry.New(1, 5000, 10000)),
+			client.WithMiddleware(proxyMiddleware),
+			client.WithMiddleware(apiKeyMiddleware),
+			client.WithMiddleware(jsonheader.New()),
+		}, opts...)...,
+	)
+
+	return httpClient, validation.New()
 }
 
+// NewLocalTestEnv creates a new client.Client instance and a validator.Validate for testing against a local server.
+// Every request is sent to the server at serverURL instead of its original host, with a fixed Open Cloud API key.
+func NewLocalTestEnv(serverURL string, opts ...client.Option) (*client.Client, *validator.Validate) {
+	target, err := url.Parse(serverURL)
+	if err != nil {
+		panic(err)
+	}
+
+	httpClient := client.NewClient(
+		append([]client.Option{
+			client.WithMiddleware(&redirectMiddleware{target: target}),
+			client.WithMiddleware(retry.New(1, 5000, 10000)),
+			client.WithMiddleware(apikey.New([]string{LocalTestAPIKey})),
+			client.WithMiddleware(jsonheader.New()),
+		}, opts...)...,
+	)
+
+	return httpClient, validation.New()
+}
+
+// WriteJSON writes body as a JSON response from a local test server.
+func WriteJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// WriteCloudError writes an Open Cloud error response from a local test server.
+func WriteCloudError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{"code": code, "message": message})
+}
+
+// redirectMiddleware sends every request to a local server instead of its original host.
+type redirectMiddleware struct {
+	target *url.URL
+}
+
+// Process rewrites the request URL and passes the request to the next middleware.
+func (m *redirectMiddleware) Process(ctx context.Context, httpClient *http.Client, req *http.Request, next middleware.NextFunc) (*http.Response, error) {
+	req.URL.Scheme = m.target.Scheme
+	req.URL.Host = m.target.Host
+	req.Host = m.target.Host
+
+	return next(ctx, httpClient, req)
+}
+
+// SetLogger is a no-op; the redirect has nothing to log.
+func (m *redirectMiddleware) SetLogger(logger.Logger) {}
+
 // parseProxy parses a proxy string in the format IP:Port:Username:Password into a URL.
 func parseProxy(raw string) (*url.URL, error) {
 	if raw == "" {
@@ -1,34 +1,44 @@
 package api
 
 import (
-	"github.com/go-playground/validator/v10"
 	"github.com/jaxron/axonet/pkg/client"
 	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
 	"github.com/jaxron/roapi.go/pkg/api/middleware/jsonheader"
 	"github.com/jaxron/roapi.go/pkg/api/resources/avatar"
+	"github.com/jaxron/roapi.go/pkg/api/resources/badges"
 	"github.com/jaxron/roapi.go/pkg/api/resources/catalog"
+	"github.com/jaxron/roapi.go/pkg/api/resources/economy"
 	"github.com/jaxron/roapi.go/pkg/api/resources/friends"
 	"github.com/jaxron/roapi.go/pkg/api/resources/games"
 	"github.com/jaxron/roapi.go/pkg/api/resources/groups"
 	"github.com/jaxron/roapi.go/pkg/api/resources/inventory"
 	"github.com/jaxron/roapi.go/pkg/api/resources/presence"
+	"github.com/jaxron/roapi.go/pkg/api/resources/privatemessages"
+	"github.com/jaxron/roapi.go/pkg/api/resources/sessions"
 	"github.com/jaxron/roapi.go/pkg/api/resources/thumbnails"
+	"github.com/jaxron/roapi.go/pkg/api/resources/trades"
 	"github.com/jaxron/roapi.go/pkg/api/resources/users"
+	"github.com/jaxron/roapi.go/pkg/api/validation"
 )
 
 // API represents the main struct for interacting with the Roblox API.
 // It contains a client for making HTTP requests and services for different API endpoints.
 type API struct {
-	client     *client.Client       // Axonet client for making API requests
-	users      *users.Resource      // Resource for user-related API operations
-	friends    *friends.Resource    // Resource for friend-related API operations
-	catalog    *catalog.Resource    // Resource for catalog-related API operations
-	groups     *groups.Resource     // Resource for group-related API operations
-	thumbnails *thumbnails.Resource // Resource for thumbnail-related API operations
-	avatar     *avatar.Resource     // Resource for avatar-related API operations
-	presence   *presence.Resource   // Resource for presence-related API operations
-	games      *games.Resource      // Resource for game-related API operations
-	inventory  *inventory.Resource  // Resource for inventory-related API operations
+	client          *client.Client            // Axonet client for making API requests
+	users           *users.Resource           // Resource for user-related API operations
+	friends         *friends.Resource         // Resource for friend-related API operations
+	catalog         *catalog.Resource         // Resource for catalog-related API operations
+	groups          *groups.Resource          // Resource for group-related API operations
+	thumbnails      *thumbnails.Resource      // Resource for thumbnail-related API operations
+	avatar          *avatar.Resource          // Resource for avatar-related API operations
+	presence        *presence.Resource        // Resource for presence-related API operations
+	games           *games.Resource           // Resource for game-related API operations
+	inventory       *inventory.Resource       // Resource for inventory-related API operations
+	badges          *badges.Resource          // Resource for badge-related API operations
+	economy         *economy.Resource         // Resource for economy-related API operations
+	trades          *trades.Resource          // Resource for trade-related API operations
+	privateMessages *privatemessages.Resource // Resource for private message-related API operations
+	sessions        *sessions.Resource        // Resource for session-related API operations
 }
 
 // New creates a new instance of API with the provided options.
@@ -48,19 +58,24 @@ func New(cookies []string, opts ...client.Option) *API {
 	authMiddleware.Shuffle()
 
 	// Return a new API instance with initialized client and resources
-	v := validator.New(validator.WithRequiredStructEnabled())
+	v := validation.New()
 
 	return &API{
-		client:     c,
-		users:      users.New(c, v),
-		friends:    friends.New(c, v),
-		catalog:    catalog.New(c, v),
-		groups:     groups.New(c, v),
-		thumbnails: thumbnails.New(c, v),
-		avatar:     avatar.New(c, v),
-		presence:   presence.New(c, v),
-		games:      games.New(c, v),
-		inventory:  inventory.New(c, v),
+		client:          c,
+		users:           users.New(c, v),
+		friends:         friends.New(c, v),
+		catalog:         catalog.New(c, v),
+		groups:          groups.New(c, v),
+		thumbnails:      thumbnails.New(c, v),
+		avatar:          avatar.New(c, v),
+		presence:        presence.New(c, v),
+		games:           games.New(c, v),
+		inventory:       inventory.New(c, v),
+		badges:          badges.New(c, v),
+		economy:         economy.New(c, v),
+		trades:          trades.New(c, v),
+		privateMessages: privatemessages.New(c, v),
+		sessions:        sessions.New(c, v, authMiddleware),
 	}
 }
 
@@ -123,3 +138,34 @@ func (api *API) Games() *games.Resource {
 func (api *API) Inventory() *inventory.Resource {
 	return api.inventory
 }
+
+// Badges returns the Resource instance for badge-related operations.
+// This provides access to methods for interacting with badge data via the Roblox API.
+func (api *API) Badges() *badges.Resource {
+	return api.badges
+}
+
+// Economy returns the Resource instance for economy-related operations.
+// This provides access to methods for interacting with economy data via the Roblox API.
+func (api *API) Economy() *economy.Resource {
+	return api.economy
+}
+
+// Trades returns the Resource instance for trade-related operations.
+// This provides access to methods for interacting with trade data via the Roblox API.
+func (api *API) Trades() *trades.Resource {
+	return api.trades
+}
+
+// PrivateMessages returns the Resource instance for private message-related operations.
+// This provides access to methods for interacting with private message data via the Roblox API.
+func (api *API) PrivateMessages() *privatemessages.Resource {
+	return api.privateMessages
+}
+
+// Sessions returns the Resource instance for session-related operations.
+// This provides access to methods for managing login sessions via the Roblox API.
+// Cookies that are refreshed or logged out are updated in the API's cookie rotation.
+func (api *API) Sessions() *sessions.Resource {
+	return api.sessions
+}
@@ -0,0 +1,88 @@
+package errs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	clientErrors "github.com/jaxron/axonet/pkg/client/errs"
+)
+
+// CloudErrorCode is the status code of an Open Cloud error.
+type CloudErrorCode string
+
+const (
+	CloudErrorInvalidArgument    CloudErrorCode = "INVALID_ARGUMENT"
+	CloudErrorUnauthenticated    CloudErrorCode = "UNAUTHENTICATED"
+	CloudErrorPermissionDenied   CloudErrorCode = "PERMISSION_DENIED"
+	CloudErrorNotFound           CloudErrorCode = "NOT_FOUND"
+	CloudErrorAlreadyExists      CloudErrorCode = "ALREADY_EXISTS"
+	CloudErrorAborted            CloudErrorCode = "ABORTED"
+	CloudErrorFailedPrecondition CloudErrorCode = "FAILED_PRECONDITION"
+	CloudErrorResourceExhausted  CloudErrorCode = "RESOURCE_EXHAUSTED"
+	CloudErrorCancelled          CloudErrorCode = "CANCELLED"
+	CloudErrorInternal           CloudErrorCode = "INTERNAL"
+	CloudErrorNotImplemented     CloudErrorCode = "NOT_IMPLEMENTED"
+	CloudErrorUnavailable        CloudErrorCode = "UNAVAILABLE"
+)
+
+// CloudError represents an error returned by the Roblox Open Cloud API.
+type CloudError struct {
+	StatusCode int               `json:"-"`       // HTTP status code of the response
+	Code       CloudErrorCode    `json:"code"`    // Status code of the error (e.g., "NOT_FOUND")
+	Message    string            `json:"message"` // Developer-facing description of the error
+	Details    []json.RawMessage `json:"details"` // Additional error details, each with an "@type" field
+}
+
+// Error implements the error interface for CloudErrors.
+func (ce *CloudError) Error() string {
+	return fmt.Sprintf("roblox open cloud error (%d %s): %s", ce.StatusCode, ce.Code, ce.Message)
+}
+
+// HandleCloudError checks if the error is a bad status error and parses the Open Cloud error if so.
+// It returns the original error if it's not a bad status error.
+func HandleCloudError(resp *http.Response, err error) error {
+	if errors.Is(err, clientErrors.ErrBadStatus) {
+		return NewCloudError(resp)
+	}
+
+	return err
+}
+
+// NewCloudError parses the response body into a CloudError.
+// Older Open Cloud endpoints report errors as "error" and "errorDetails", which are read as well.
+func NewCloudError(resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%w: code %d", ErrReadBody, resp.StatusCode)
+	}
+
+	var cloudError struct {
+		CloudError
+
+		LegacyCode    CloudErrorCode    `json:"error"`
+		LegacyDetails []json.RawMessage `json:"errorDetails"`
+	}
+	if err := json.Unmarshal(body, &cloudError); err != nil {
+		return fmt.Errorf("%w: code %d", ErrParseJSON, resp.StatusCode)
+	}
+
+	result := cloudError.CloudError
+	result.StatusCode = resp.StatusCode
+
+	if result.Code == "" {
+		result.Code = cloudError.LegacyCode
+	}
+
+	if result.Details == nil {
+		result.Details = cloudError.LegacyDetails
+	}
+
+	if result.Code == "" && result.Message == "" {
+		return fmt.Errorf("roblox open cloud error (%d): %w", resp.StatusCode, ErrNoMessage)
+	}
+
+	return &result
+}
@@ -0,0 +1,110 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jaxron/axonet/pkg/client/logger"
+	"github.com/jaxron/axonet/pkg/client/middleware"
+)
+
+// HeaderAPIKey is the header Open Cloud reads the API key from.
+const HeaderAPIKey = "X-Api-Key"
+
+var ErrNoAPIKey = errors.New("no API key available")
+
+// Middleware manages API key rotation for Open Cloud requests.
+type Middleware struct {
+	keys     []string
+	keyCount int
+	keysMux  sync.RWMutex
+	current  atomic.Int64
+	logger   logger.Logger
+}
+
+// New creates a new APIKeyMiddleware instance.
+func New(keys []string) *Middleware {
+	m := &Middleware{
+		keys:     keys,
+		keyCount: len(keys),
+		keysMux:  sync.RWMutex{},
+		current:  atomic.Int64{},
+		logger:   &logger.NoOpLogger{},
+	}
+	m.current.Store(0)
+
+	return m
+}
+
+// Process applies the next API key in the rotation before passing the request to the next middleware.
+// Requests that already carry an API key are left untouched.
+func (m *Middleware) Process(ctx context.Context, httpClient *http.Client, req *http.Request, next middleware.NextFunc) (*http.Response, error) {
+	if req.Header.Get(HeaderAPIKey) != "" {
+		return next(ctx, httpClient, req)
+	}
+
+	key, err := m.nextKey()
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set(HeaderAPIKey, key)
+	m.logger.Debug("Applied API key to request")
+
+	return next(ctx, httpClient, req)
+}
+
+// UpdateAPIKeys updates the list of API keys at runtime.
+func (m *Middleware) UpdateAPIKeys(keys []string) {
+	m.keysMux.Lock()
+	defer m.keysMux.Unlock()
+
+	m.keys = keys
+	m.current.Store(0)
+	m.keyCount = len(keys)
+	m.logger.WithFields(logger.Int("keys", len(keys))).Debug("API keys updated")
+}
+
+// Shuffle randomizes the order of the API keys.
+func (m *Middleware) Shuffle() {
+	m.keysMux.Lock()
+	defer m.keysMux.Unlock()
+
+	rand.New(rand.NewSource(time.Now().UnixNano())).Shuffle(len(m.keys), func(i, j int) {
+		m.keys[i], m.keys[j] = m.keys[j], m.keys[i]
+	})
+
+	m.logger.Debug("API keys shuffled")
+}
+
+// GetAPIKeyCount returns the current number of API keys in the list.
+func (m *Middleware) GetAPIKeyCount() int {
+	m.keysMux.RLock()
+	defer m.keysMux.RUnlock()
+
+	return m.keyCount
+}
+
+// SetLogger sets the logger for the middleware.
+func (m *Middleware) SetLogger(l logger.Logger) {
+	m.logger = l
+}
+
+func (m *Middleware) nextKey() (string, error) {
+	m.keysMux.RLock()
+	defer m.keysMux.RUnlock()
+
+	if m.keyCount == 0 {
+		return "", ErrNoAPIKey
+	}
+
+	current := m.current.Add(1) - 1
+	index := current % int64(m.keyCount) // #nosec G115
+
+	return m.keys[index], nil
+}
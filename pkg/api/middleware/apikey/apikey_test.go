@@ -0,0 +1,73 @@
+package apikey_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jaxron/axonet/pkg/client/logger"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/apikey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeyMiddleware(t *testing.T) {
+	t.Run("Rotate API keys", func(t *testing.T) {
+		t.Parallel()
+
+		middleware := apikey.New([]string{"key1", "key2"})
+		middleware.SetLogger(logger.NewBasicLogger())
+
+		seen := make([]string, 0, 3)
+
+		for range 3 {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+			_, err := middleware.Process(context.Background(), &http.Client{}, req, func(ctx context.Context, httpClient *http.Client, req *http.Request) (*http.Response, error) {
+				seen = append(seen, req.Header.Get(apikey.HeaderAPIKey))
+				return &http.Response{StatusCode: http.StatusOK}, nil
+			})
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, []string{"key1", "key2", "key1"}, seen)
+	})
+
+	t.Run("Keep explicit API key", func(t *testing.T) {
+		t.Parallel()
+
+		middleware := apikey.New([]string{"key1"})
+
+		req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		req.Header.Set(apikey.HeaderAPIKey, "explicit")
+
+		_, err := middleware.Process(context.Background(), &http.Client{}, req, func(ctx context.Context, httpClient *http.Client, req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "explicit", req.Header.Get(apikey.HeaderAPIKey))
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Fail without API keys", func(t *testing.T) {
+		t.Parallel()
+
+		middleware := apikey.New(nil)
+
+		req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		_, err := middleware.Process(context.Background(), &http.Client{}, req, func(ctx context.Context, httpClient *http.Client, req *http.Request) (*http.Response, error) {
+			t.Fatal("request should not be sent")
+			return nil, nil
+		})
+		require.ErrorIs(t, err, apikey.ErrNoAPIKey)
+	})
+
+	t.Run("Update API keys at runtime", func(t *testing.T) {
+		t.Parallel()
+
+		middleware := apikey.New([]string{"key1"})
+		assert.Equal(t, 1, middleware.GetAPIKeyCount())
+
+		middleware.UpdateAPIKeys([]string{"key2", "key3"})
+		assert.Equal(t, 2, middleware.GetAPIKeyCount())
+	})
+}
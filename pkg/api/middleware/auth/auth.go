@@ -5,6 +5,7 @@ import (
 	"errors"
 	"math/rand"
 	"net/http"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -18,6 +19,7 @@ type contextKey int
 const (
 	KeyAddCookie contextKey = iota
 	KeyAddToken
+	KeyUseCookie // Pins a specific cookie (string) instead of the next one in the rotation
 )
 
 var (
@@ -67,9 +69,12 @@ func (m *Middleware) Process(ctx context.Context, httpClient *http.Client, req *
 	}
 
 	// Apply cookie and token to the request if required
-	cookie, err := m.getAndValidateCookie()
-	if err != nil {
-		return nil, err
+	cookie, pinned := ctx.Value(KeyUseCookie).(string)
+	if !pinned || cookie == "" {
+		var err error
+		if cookie, err = m.getAndValidateCookie(); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := m.applyCookieAndToken(ctx, httpClient, req, cookie, isCookieEnabled, isTokenEnabled); err != nil {
@@ -90,6 +95,41 @@ func (m *Middleware) UpdateCookies(cookies []string) {
 	m.logger.WithFields(logger.Int("cookies", len(cookies))).Debug("Cookies updated")
 }
 
+// ReplaceCookie swaps a cookie for a new one, such as after the session was refreshed.
+// It reports whether the old cookie was found.
+func (m *Middleware) ReplaceCookie(oldCookie, newCookie string) bool {
+	m.cookiesMux.Lock()
+	defer m.cookiesMux.Unlock()
+
+	index := slices.Index(m.cookies, oldCookie)
+	if index < 0 {
+		return false
+	}
+
+	m.cookies[index] = newCookie
+	m.logger.Debug("Cookie replaced")
+
+	return true
+}
+
+// RemoveCookie removes a cookie from the rotation, such as after its session was revoked.
+// It reports whether the cookie was found.
+func (m *Middleware) RemoveCookie(cookie string) bool {
+	m.cookiesMux.Lock()
+	defer m.cookiesMux.Unlock()
+
+	index := slices.Index(m.cookies, cookie)
+	if index < 0 {
+		return false
+	}
+
+	m.cookies = slices.Delete(slices.Clone(m.cookies), index, index+1)
+	m.cookieCount = len(m.cookies)
+	m.logger.WithFields(logger.Int("cookies", m.cookieCount)).Debug("Cookie removed")
+
+	return true
+}
+
 // Shuffle randomizes the order of the cookies.
 func (m *Middleware) Shuffle() {
 	m.cookiesMux.Lock()
@@ -110,6 +150,12 @@ func (m *Middleware) GetCookieCount() int {
 	return m.cookieCount
 }
 
+// NextCookie returns the next cookie in the rotation.
+// It is meant for connections that cannot go through the middleware, such as websockets.
+func (m *Middleware) NextCookie() (string, error) {
+	return m.getAndValidateCookie()
+}
+
 // SetLogger sets the logger for the middleware.
 func (m *Middleware) SetLogger(l logger.Logger) {
 	m.logger = l
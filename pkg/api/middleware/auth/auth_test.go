@@ -92,6 +92,45 @@ func TestAuthMiddleware(t *testing.T) {
 		assert.Equal(t, 3, middleware.GetCookieCount())
 	})
 
+	t.Run("Use pinned cookie", func(t *testing.T) {
+		t.Parallel()
+
+		middleware := auth.New([]string{"cookie1", "cookie2"})
+		middleware.SetLogger(logger.NewBasicLogger())
+
+		ctx := context.WithValue(context.Background(), auth.KeyAddCookie, true)
+		ctx = context.WithValue(ctx, auth.KeyUseCookie, "pinned")
+
+		for range 2 {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+			_, err := middleware.Process(ctx, &http.Client{}, req, func(ctx context.Context, httpClient *http.Client, req *http.Request) (*http.Response, error) {
+				assert.Equal(t, ".ROBLOSECURITY=pinned", req.Header.Get("Cookie"))
+				return &http.Response{StatusCode: http.StatusOK}, nil
+			})
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("Replace and remove cookies", func(t *testing.T) {
+		t.Parallel()
+
+		middleware := auth.New([]string{"cookie1", "cookie2"})
+		middleware.SetLogger(logger.NewBasicLogger())
+
+		assert.True(t, middleware.ReplaceCookie("cookie1", "cookie3"))
+		assert.False(t, middleware.ReplaceCookie("cookie1", "cookie4"))
+		assert.True(t, middleware.RemoveCookie("cookie2"))
+		assert.False(t, middleware.RemoveCookie("cookie2"))
+		assert.Equal(t, 1, middleware.GetCookieCount())
+
+		cookie, err := middleware.NextCookie()
+		require.NoError(t, err)
+		assert.Equal(t, "cookie3", cookie)
+
+		assert.True(t, middleware.RemoveCookie("cookie3"))
+		_, err = middleware.NextCookie()
+		require.ErrorIs(t, err, auth.ErrNoCookie)
+	})
 }
 
 func TestCSRFTokenCaching(t *testing.T) {
@@ -0,0 +1,223 @@
+package challenge
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/jaxron/axonet/pkg/client/logger"
+	"github.com/jaxron/axonet/pkg/client/middleware"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// Headers used to raise and answer challenges.
+const (
+	HeaderChallengeID       = "Rblx-Challenge-Id"
+	HeaderChallengeType     = "Rblx-Challenge-Type"
+	HeaderChallengeMetadata = "Rblx-Challenge-Metadata"
+)
+
+var (
+	ErrChallengeFailed = errors.New("failed to solve challenge")
+	ErrContinueFailed  = errors.New("challenge was not accepted")
+)
+
+// ChallengeSolver solves challenges of a single type.
+type ChallengeSolver interface {
+	// Type returns the type of challenge the solver handles.
+	Type() Type
+
+	// Solve solves the challenge and returns the metadata to continue it with, which is
+	// encoded as JSON. The HTTP client is the raw client, without any middleware.
+	Solve(ctx context.Context, httpClient *http.Client, challenge *Challenge) (any, error)
+}
+
+// Middleware solves challenges raised through the rblx-challenge-* response headers and
+// replays the original request with the solved challenge.
+//
+// The cookie and CSRF token of the original request are reused to continue the challenge,
+// and the replay is sent as the same cookie whether the middleware comes before or after
+// the auth middleware. Challenges without a registered solver are passed through untouched.
+type Middleware struct {
+	solvers  map[Type]ChallengeSolver
+	endpoint string
+	logger   logger.Logger
+}
+
+// New creates a new Middleware with the given solvers.
+func New(solvers ...ChallengeSolver) *Middleware {
+	m := &Middleware{
+		solvers:  make(map[Type]ChallengeSolver, len(solvers)),
+		endpoint: types.ApisEndpoint,
+		logger:   &logger.NoOpLogger{},
+	}
+
+	for _, solver := range solvers {
+		m.solvers[solver.Type()] = solver
+	}
+
+	return m
+}
+
+// Process sends the request and, if it is challenged, solves the challenge and replays it once.
+func (m *Middleware) Process(ctx context.Context, httpClient *http.Client, req *http.Request, next middleware.NextFunc) (*http.Response, error) {
+	// Without a cookie yet, the auth middleware comes later and adds one to the request
+	authAfter := req.Header.Get("Cookie") == ""
+
+	resp, err := next(ctx, httpClient, req)
+	if resp == nil {
+		return resp, err
+	}
+
+	challenge, found, parseErr := parseChallenge(
+		resp.Header.Get(HeaderChallengeID),
+		resp.Header.Get(HeaderChallengeType),
+		resp.Header.Get(HeaderChallengeMetadata),
+	)
+	if !found {
+		return resp, err
+	}
+
+	if parseErr != nil {
+		m.logger.WithFields(logger.String("error", parseErr.Error())).Warn("Failed to parse challenge")
+		return resp, err
+	}
+
+	solver, ok := m.solvers[challenge.Type]
+	if !ok {
+		m.logger.WithFields(logger.String("type", string(challenge.Type))).Debug("No solver for challenge")
+		return resp, err
+	}
+
+	// The challenged response is replaced by the replayed one
+	_ = resp.Body.Close()
+
+	m.logger.WithFields(logger.String("type", string(challenge.Type))).Debug("Solving challenge")
+
+	solution, err := solver.Solve(ctx, httpClient, challenge)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrChallengeFailed, challenge.Type, err)
+	}
+
+	metadata, err := json.Marshal(solution)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrChallengeFailed, challenge.Type, err)
+	}
+
+	if err := m.continueChallenge(ctx, httpClient, req, challenge, metadata); err != nil {
+		return nil, err
+	}
+
+	replay, err := replayRequest(ctx, req, challenge, metadata, authAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	return next(replay.Context(), httpClient, replay)
+}
+
+// SetLogger sets the logger for the middleware.
+func (m *Middleware) SetLogger(l logger.Logger) {
+	m.logger = l
+}
+
+// SetEndpoint sets the base URL of the challenge service (useful for testing).
+func (m *Middleware) SetEndpoint(endpoint string) {
+	m.endpoint = endpoint
+}
+
+// continueChallenge reports the solved challenge to the challenge service.
+// POST https://apis.roblox.com/challenge/v1/continue
+func (m *Middleware) continueChallenge(ctx context.Context, httpClient *http.Client, original *http.Request, challenge *Challenge, metadata []byte) error {
+	body, err := json.Marshal(map[string]string{
+		"challengeId":       challenge.ID,
+		"challengeType":     string(challenge.Type),
+		"challengeMetadata": string(metadata),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.endpoint+"/challenge/v1/continue", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	// Continue as the same user that was challenged
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	for _, key := range []string{"Cookie", "X-Csrf-Token"} {
+		for _, value := range original.Header.Values(key) {
+			req.Header.Add(key, value)
+		}
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrContinueFailed, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%w: status %d", ErrContinueFailed, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// replayRequest copies the original request and attaches the solved challenge headers.
+// If the auth middleware comes later, the cookie it added is removed and pinned instead,
+// so that the replay carries the same cookie once rather than the next one in the rotation.
+func replayRequest(ctx context.Context, original *http.Request, challenge *Challenge, metadata []byte, authAfter bool) (*http.Request, error) {
+	if authAfter {
+		if cookie, found := roblosecurity(original); found {
+			ctx = context.WithValue(ctx, auth.KeyUseCookie, cookie)
+		}
+	}
+
+	replay := original.Clone(ctx)
+	if authAfter {
+		replay.Header.Del("Cookie")
+	}
+
+	if original.Body != nil && original.Body != http.NoBody {
+		if original.GetBody == nil {
+			return nil, fmt.Errorf("%w: request body cannot be replayed", ErrChallengeFailed)
+		}
+
+		body, err := original.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrChallengeFailed, err)
+		}
+
+		replay.Body = body
+	}
+
+	replay.Header.Set(HeaderChallengeID, challenge.ID)
+	replay.Header.Set(HeaderChallengeType, string(challenge.Type))
+	replay.Header.Set(HeaderChallengeMetadata, base64.StdEncoding.EncodeToString(metadata))
+
+	return replay, nil
+}
+
+// roblosecurity returns the .ROBLOSECURITY cookie of a request.
+// The value is read as is, since http.Request.Cookie drops values it considers invalid.
+func roblosecurity(req *http.Request) (string, bool) {
+	for _, header := range req.Header.Values("Cookie") {
+		for part := range strings.SplitSeq(header, ";") {
+			if value, found := strings.CutPrefix(strings.TrimSpace(part), ".ROBLOSECURITY="); found {
+				return value, true
+			}
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,283 @@
+package challenge_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/challenge"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errNoCaptchaService = errors.New("no captcha service")
+
+// challengeServer serves a protected endpoint that raises a challenge of the given type
+// until it is replayed with the expected solution, along with the challenge and
+// proof-of-work services.
+type challengeServer struct {
+	*httptest.Server
+
+	challengeType string
+	metadata      string
+	continued     atomic.Bool
+	replays       atomic.Int32
+	continueAuth  atomic.Value
+	cookies       atomic.Value // Cookie header values of the challenged request
+	replayCookies atomic.Value // Cookie header values of the replayed request
+}
+
+func newChallengeServer(t *testing.T, challengeType string, metadata any) *challengeServer {
+	t.Helper()
+
+	encoded, err := json.Marshal(metadata)
+	require.NoError(t, err)
+
+	s := &challengeServer{challengeType: challengeType, metadata: base64.StdEncoding.EncodeToString(encoded)}
+
+	// Puzzle parameters small enough to solve instantly
+	const modulus, base, squarings = "3233", "65", 10
+
+	expected := new(big.Int).Exp(big.NewInt(65), new(big.Int).Lsh(big.NewInt(1), squarings), big.NewInt(3233)).String()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /protected", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		solved, _ := base64.StdEncoding.DecodeString(r.Header.Get(challenge.HeaderChallengeMetadata))
+		if r.Header.Get(challenge.HeaderChallengeID) == "challenge-1" && s.continued.Load() {
+			s.replays.Add(1)
+			s.replayCookies.Store(r.Header.Values("Cookie"))
+			_ = json.NewEncoder(w).Encode(map[string]string{"body": string(body), "metadata": string(solved)})
+
+			return
+		}
+
+		s.cookies.Store(r.Header.Values("Cookie"))
+		w.Header().Set(challenge.HeaderChallengeID, "challenge-1")
+		w.Header().Set(challenge.HeaderChallengeType, s.challengeType)
+		w.Header().Set(challenge.HeaderChallengeMetadata, s.metadata)
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":[{"code":0,"message":"Challenge is required to authorize the request"}]}`))
+	})
+	mux.HandleFunc("POST /challenge/v1/continue", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			ChallengeID   string `json:"challengeId"`
+			ChallengeType string `json:"challengeType"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.ChallengeID != "challenge-1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		s.continueAuth.Store(r.Header.Get("Cookie"))
+		s.continued.Store(true)
+		_, _ = w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("GET /proof-of-work-service/v1/pow-puzzle", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("sessionID") != "session-1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		artifacts, _ := json.Marshal(map[string]any{"N": modulus, "A": base, "T": squarings})
+		_ = json.NewEncoder(w).Encode(map[string]string{"artifacts": string(artifacts), "puzzleType": "TimeLockPuzzle"})
+	})
+	mux.HandleFunc("POST /proof-of-work-service/v1/pow-puzzle", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			SessionID string `json:"sessionID"`
+			Solution  string `json:"solution"`
+		}
+
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		correct := body.SessionID == "session-1" && body.Solution == expected
+		_ = json.NewEncoder(w).Encode(map[string]any{"answerCorrect": correct, "redemptionToken": "redeem-1"})
+	})
+
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+// captchaSolver records the challenge it is given and fails or answers with a fixed token.
+type captchaSolver struct {
+	received *challenge.Challenge
+	err      error
+}
+
+func (s *captchaSolver) Type() challenge.Type {
+	return challenge.TypeCaptcha
+}
+
+func (s *captchaSolver) Solve(_ context.Context, _ *http.Client, c *challenge.Challenge) (any, error) {
+	s.received = c
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return map[string]string{"captchaToken": "token"}, nil
+}
+
+func TestChallengeMiddleware(t *testing.T) {
+	ctx := context.WithValue(context.Background(), auth.KeyAddCookie, true)
+
+	newClient := func(server *challengeServer, solvers ...challenge.ChallengeSolver) *client.Client {
+		middleware := challenge.New(solvers...)
+		middleware.SetEndpoint(server.URL)
+
+		return client.NewClient(
+			client.WithMiddleware(auth.New([]string{"cookie"})),
+			client.WithMiddleware(middleware),
+		)
+	}
+
+	t.Run("Solve Proof Of Work And Replay", func(t *testing.T) {
+		server := newChallengeServer(t, "proofofwork", map[string]string{"sessionId": "session-1"})
+		defer server.Close()
+
+		solver := challenge.NewProofOfWorkSolver()
+		solver.SetEndpoint(server.URL)
+
+		var result map[string]string
+
+		resp, err := newClient(server, solver).NewRequest().
+			Method(http.MethodPost).
+			URL(server.URL + "/protected").
+			Body([]byte(`{"hello":"world"}`)).
+			Result(&result).
+			Do(ctx)
+		require.NoError(t, err)
+
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.JSONEq(t, `{"hello":"world"}`, result["body"])
+		assert.JSONEq(t, `{"redemptionToken":"redeem-1","sessionId":"session-1"}`, result["metadata"])
+		assert.Equal(t, int32(1), server.replays.Load())
+		assert.Equal(t, ".ROBLOSECURITY=cookie", server.continueAuth.Load())
+	})
+
+	t.Run("Decode Typed Metadata", func(t *testing.T) {
+		server := newChallengeServer(t, "captcha", map[string]string{
+			"unifiedCaptchaId": "captcha-1",
+			"dataExchangeBlob": "blob",
+			"actionType":       "Login",
+		})
+		defer server.Close()
+
+		solver := &captchaSolver{}
+
+		resp, err := newClient(server, solver).NewRequest().
+			Method(http.MethodPost).
+			URL(server.URL + "/protected").
+			Do(ctx)
+		require.NoError(t, err)
+
+		_ = resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		require.NotNil(t, solver.received)
+		assert.Equal(t, "challenge-1", solver.received.ID)
+
+		metadata, ok := solver.received.Metadata.(*challenge.CaptchaMetadata)
+		require.True(t, ok)
+		assert.Equal(t, "captcha-1", metadata.UnifiedCaptchaID)
+		assert.Equal(t, "blob", metadata.DataExchangeBlob)
+		assert.Equal(t, "Login", metadata.ActionType)
+	})
+
+	t.Run("Pass Through Challenges Without Solver", func(t *testing.T) {
+		server := newChallengeServer(t, "reauthentication", map[string]any{"defaultType": "Password"})
+		defer server.Close()
+
+		resp, err := newClient(server, challenge.NewProofOfWorkSolver()).NewRequest().
+			Method(http.MethodPost).
+			URL(server.URL + "/protected").
+			Do(ctx)
+		require.NoError(t, err)
+
+		_ = resp.Body.Close()
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.False(t, server.continued.Load())
+	})
+
+	t.Run("Report Solver Errors", func(t *testing.T) {
+		server := newChallengeServer(t, "captcha", map[string]string{"unifiedCaptchaId": "captcha-1"})
+		defer server.Close()
+
+		_, err := newClient(server, &captchaSolver{err: errNoCaptchaService}).NewRequest().
+			Method(http.MethodPost).
+			URL(server.URL + "/protected").
+			Do(ctx)
+		require.ErrorIs(t, err, challenge.ErrChallengeFailed)
+		require.ErrorIs(t, err, errNoCaptchaService)
+		assert.Equal(t, int32(0), server.replays.Load())
+	})
+}
+
+func TestChallengeMiddlewareThroughAPI(t *testing.T) {
+	server := newChallengeServer(t, "captcha", map[string]string{"unifiedCaptchaId": "captcha-1"})
+	defer server.Close()
+
+	middleware := challenge.New(&captchaSolver{})
+	middleware.SetEndpoint(server.URL)
+
+	// api.New adds the auth middleware after the caller's options, so the challenge
+	// middleware wraps it and the replay goes through auth again
+	roapi := api.New([]string{"cookie-1", "cookie-2", "cookie-3"}, client.WithMiddleware(middleware))
+	ctx := context.WithValue(context.Background(), auth.KeyAddCookie, true)
+
+	resp, err := roapi.GetClient().NewRequest().
+		Method(http.MethodPost).
+		URL(server.URL + "/protected").
+		Do(ctx)
+	require.NoError(t, err)
+
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(1), server.replays.Load())
+
+	cookies, ok := server.cookies.Load().([]string)
+	require.True(t, ok)
+	require.Len(t, cookies, 1)
+
+	// The replay carries the challenged cookie exactly once
+	assert.Equal(t, cookies, server.replayCookies.Load())
+	assert.Equal(t, cookies[0], server.continueAuth.Load())
+}
+
+func TestSolveTimeLockPuzzle(t *testing.T) {
+	t.Run("Solve Puzzle", func(t *testing.T) {
+		solution, err := challenge.SolveTimeLockPuzzle(context.Background(), "3233", "65", 10)
+		require.NoError(t, err)
+
+		expected := new(big.Int).Exp(big.NewInt(65), big.NewInt(1024), big.NewInt(3233))
+		assert.Equal(t, expected.String(), solution)
+	})
+
+	t.Run("Invalid Modulus", func(t *testing.T) {
+		_, err := challenge.SolveTimeLockPuzzle(context.Background(), "abc", "65", 10)
+		require.ErrorIs(t, err, challenge.ErrUnsupportedPuzzle)
+	})
+
+	t.Run("Cancelled Context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := challenge.SolveTimeLockPuzzle(ctx, "3233", "65", 1<<20)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,110 @@
+package challenge
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// Type represents the kind of challenge Roblox asks to be solved.
+type Type string
+
+const (
+	TypeCaptcha             Type = "captcha"
+	TypeTwoStepVerification Type = "twostepverification"
+	TypeReauthentication    Type = "reauthentication"
+	TypeProofOfWork         Type = "proofofwork"
+)
+
+// Challenge is a challenge raised through the rblx-challenge-* response headers.
+type Challenge struct {
+	ID          string          // Value of the rblx-challenge-id header
+	Type        Type            // Value of the rblx-challenge-type header
+	RawMetadata json.RawMessage // Decoded rblx-challenge-metadata header
+	Metadata    any             // Typed metadata for known types, or RawMetadata for others
+}
+
+// CaptchaMetadata is the metadata of a captcha challenge.
+type CaptchaMetadata struct {
+	UnifiedCaptchaID string `json:"unifiedCaptchaId"` // ID of the captcha session
+	DataExchangeBlob string `json:"dataExchangeBlob"` // Blob to pass to the captcha provider
+	ActionType       string `json:"actionType"`       // Action the captcha protects
+}
+
+// TwoStepVerificationMetadata is the metadata of a two-step verification challenge.
+type TwoStepVerificationMetadata struct {
+	UserID                           string `json:"userId"`                           // ID of the user to verify
+	ChallengeID                      string `json:"challengeId"`                      // ID of the two-step verification challenge
+	ShouldShowRememberDeviceCheckbox bool   `json:"shouldShowRememberDeviceCheckbox"` // Whether the device can be remembered
+	ActionType                       string `json:"actionType"`                       // Action the verification protects
+}
+
+// ReauthenticationMetadata is the metadata of a reauthentication challenge.
+type ReauthenticationMetadata struct {
+	DefaultType    string   `json:"defaultType"`    // Reauthentication method offered first (e.g., "Password")
+	AvailableTypes []string `json:"availableTypes"` // Reauthentication methods available
+}
+
+// ProofOfWorkMetadata is the metadata of a proof-of-work challenge.
+type ProofOfWorkMetadata struct {
+	SessionID string `json:"sessionId"` // ID of the puzzle session
+}
+
+// parseChallenge reads a challenge from response headers.
+// It reports false if the response does not carry a challenge.
+func parseChallenge(id, challengeType, metadata string) (*Challenge, bool, error) {
+	if id == "" || challengeType == "" {
+		return nil, false, nil
+	}
+
+	challenge := &Challenge{
+		ID:          id,
+		Type:        Type(challengeType),
+		RawMetadata: nil,
+		Metadata:    nil,
+	}
+
+	if metadata == "" {
+		return challenge, true, nil
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(metadata)
+	if err != nil {
+		return nil, true, fmt.Errorf("invalid challenge metadata encoding: %w", err)
+	}
+
+	challenge.RawMetadata = raw
+
+	typed, err := decodeMetadata(challenge.Type, raw)
+	if err != nil {
+		return nil, true, fmt.Errorf("invalid %s challenge metadata: %w", challenge.Type, err)
+	}
+
+	challenge.Metadata = typed
+
+	return challenge, true, nil
+}
+
+// decodeMetadata decodes metadata into the struct for its challenge type.
+func decodeMetadata(challengeType Type, raw json.RawMessage) (any, error) {
+	var metadata any
+
+	switch challengeType {
+	case TypeCaptcha:
+		metadata = &CaptchaMetadata{}
+	case TypeTwoStepVerification:
+		metadata = &TwoStepVerificationMetadata{}
+	case TypeReauthentication:
+		metadata = &ReauthenticationMetadata{}
+	case TypeProofOfWork:
+		metadata = &ProofOfWorkMetadata{}
+	default:
+		return raw, nil
+	}
+
+	if err := json.Unmarshal(raw, metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,179 @@
+package challenge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+var (
+	ErrUnsupportedPuzzle = errors.New("unsupported proof-of-work puzzle")
+	ErrWrongAnswer       = errors.New("proof-of-work answer was rejected")
+)
+
+// timeLockPuzzle is the only puzzle type the solver knows.
+const timeLockPuzzle = "TimeLockPuzzle"
+
+// puzzleResponse is a proof-of-work puzzle.
+type puzzleResponse struct {
+	Artifacts  string `json:"artifacts"`
+	PuzzleType string `json:"puzzleType"`
+}
+
+// timeLockArtifacts are the parameters of a time-lock puzzle.
+type timeLockArtifacts struct {
+	N string `json:"N"` // Modulus in decimal
+	A string `json:"A"` // Base in decimal
+	T int64  `json:"T"` // Number of squarings
+}
+
+// answerResponse is the verdict on a proof-of-work answer.
+type answerResponse struct {
+	AnswerCorrect   bool   `json:"answerCorrect"`
+	RedemptionToken string `json:"redemptionToken"`
+}
+
+// ProofOfWorkSolver solves proof-of-work challenges by computing the time-lock puzzle
+// handed out by the proof-of-work service.
+type ProofOfWorkSolver struct {
+	endpoint string
+}
+
+// NewProofOfWorkSolver creates a new ProofOfWorkSolver.
+func NewProofOfWorkSolver() *ProofOfWorkSolver {
+	return &ProofOfWorkSolver{
+		endpoint: types.ApisEndpoint,
+	}
+}
+
+// SetEndpoint sets the base URL of the proof-of-work service (useful for testing).
+func (s *ProofOfWorkSolver) SetEndpoint(endpoint string) {
+	s.endpoint = endpoint
+}
+
+// Type returns TypeProofOfWork.
+func (s *ProofOfWorkSolver) Type() Type {
+	return TypeProofOfWork
+}
+
+// Solve fetches the puzzle for the challenge's session, solves it and redeems the answer.
+// GET https://apis.roblox.com/proof-of-work-service/v1/pow-puzzle
+// POST https://apis.roblox.com/proof-of-work-service/v1/pow-puzzle
+func (s *ProofOfWorkSolver) Solve(ctx context.Context, httpClient *http.Client, challenge *Challenge) (any, error) {
+	metadata, ok := challenge.Metadata.(*ProofOfWorkMetadata)
+	if !ok || metadata.SessionID == "" {
+		return nil, fmt.Errorf("%w: missing session ID", ErrUnsupportedPuzzle)
+	}
+
+	puzzleURL := s.endpoint + "/proof-of-work-service/v1/pow-puzzle"
+
+	var puzzle puzzleResponse
+	if err := doJSON(ctx, httpClient, http.MethodGet, puzzleURL+"?sessionID="+url.QueryEscape(metadata.SessionID), nil, &puzzle); err != nil {
+		return nil, err
+	}
+
+	if puzzle.PuzzleType != timeLockPuzzle {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedPuzzle, puzzle.PuzzleType)
+	}
+
+	var artifacts timeLockArtifacts
+	if err := json.Unmarshal([]byte(puzzle.Artifacts), &artifacts); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedPuzzle, err)
+	}
+
+	solution, err := SolveTimeLockPuzzle(ctx, artifacts.N, artifacts.A, artifacts.T)
+	if err != nil {
+		return nil, err
+	}
+
+	var answer answerResponse
+
+	body := map[string]string{"sessionID": metadata.SessionID, "solution": solution}
+	if err := doJSON(ctx, httpClient, http.MethodPost, puzzleURL, body, &answer); err != nil {
+		return nil, err
+	}
+
+	if !answer.AnswerCorrect {
+		return nil, ErrWrongAnswer
+	}
+
+	return map[string]string{
+		"redemptionToken": answer.RedemptionToken,
+		"sessionId":       metadata.SessionID,
+	}, nil
+}
+
+// SolveTimeLockPuzzle computes a^(2^t) mod n by repeated squaring, with n and a given in
+// decimal, and returns the result in decimal. The context is checked periodically, as
+// puzzles are sized to take a noticeable amount of time.
+func SolveTimeLockPuzzle(ctx context.Context, n, a string, t int64) (string, error) {
+	modulus, ok := new(big.Int).SetString(n, 10)
+	if !ok || modulus.Sign() <= 0 {
+		return "", fmt.Errorf("%w: invalid modulus", ErrUnsupportedPuzzle)
+	}
+
+	value, ok := new(big.Int).SetString(a, 10)
+	if !ok {
+		return "", fmt.Errorf("%w: invalid base", ErrUnsupportedPuzzle)
+	}
+
+	if t < 0 {
+		return "", fmt.Errorf("%w: negative squaring count", ErrUnsupportedPuzzle)
+	}
+
+	value.Mod(value, modulus)
+
+	for i := range t {
+		if i%4096 == 0 && ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+
+		value.Mul(value, value)
+		value.Mod(value, modulus)
+	}
+
+	return value.String(), nil
+}
+
+// doJSON sends a JSON request and decodes the JSON response.
+func doJSON(ctx context.Context, httpClient *http.Client, method, url string, body, result any) error {
+	var reader *bytes.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		reader = bytes.NewReader(encoded)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%w: %s %s returned status %d", ErrChallengeFailed, method, url, resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
@@ -9,6 +9,8 @@ import (
 )
 
 // Middleware adds application/json headers to HTTP requests.
+// A Content-Type set on the request is kept, so that requests can still send other
+// bodies such as multipart uploads.
 type Middleware struct {
 	logger logger.Logger
 }
@@ -24,7 +26,10 @@ func New() *Middleware {
 func (m *Middleware) Process(ctx context.Context, httpClient *http.Client, req *http.Request, next middleware.NextFunc) (*http.Response, error) {
 	m.logger.Debug("Adding JSON headers to request")
 
-	req.Header.Set("Content-Type", "application/json")
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
 	req.Header.Set("Accept", "application/json")
 
 	return next(ctx, httpClient, req)
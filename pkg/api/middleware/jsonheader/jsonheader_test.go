@@ -30,4 +30,22 @@ func TestJSONHeaderMiddleware(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 	})
+
+	t.Run("Keep explicit content type", func(t *testing.T) {
+		t.Parallel()
+
+		middleware := jsonheader.New()
+
+		req := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+		req.Header.Set("Content-Type", "multipart/form-data; boundary=test")
+
+		resp, err := middleware.Process(context.Background(), &http.Client{}, req, func(ctx context.Context, httpClient *http.Client, req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "multipart/form-data; boundary=test", req.Header.Get("Content-Type"))
+			assert.Equal(t, "application/json", req.Header.Get("Accept"))
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
 }
@@ -0,0 +1,98 @@
+// Package opencloud provides access to the Roblox Open Cloud API, which authenticates with
+// API keys instead of cookies.
+package opencloud
+
+import (
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/apikey"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/jsonheader"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/resources/assets"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/resources/datastores"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/resources/memorystore"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/resources/universes"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/resources/userrestrictions"
+	"github.com/jaxron/roapi.go/pkg/api/validation"
+)
+
+// API represents the main struct for interacting with the Roblox Open Cloud API.
+// It contains a client for making HTTP requests and services for different API endpoints.
+type API struct {
+	client           *client.Client             // Axonet client for making API requests
+	apiKeys          *apikey.Middleware         // Middleware rotating the API keys
+	assets           *assets.Resource           // Resource for asset-related API operations
+	dataStores       *datastores.Resource       // Resource for data store-related API operations
+	memoryStore      *memorystore.Resource      // Resource for memory store-related API operations
+	universes        *universes.Resource        // Resource for universe-related API operations
+	userRestrictions *userrestrictions.Resource // Resource for user restriction-related API operations
+}
+
+// New creates a new instance of API with the provided API keys and options.
+// It initializes the client and sets up the services.
+//
+//goland:noinspection GoUnusedExportedFunction
+func New(apiKeys []string, opts ...client.Option) *API {
+	// Initialize the client with custom options and middleware
+	apiKeyMiddleware := apikey.New(apiKeys)
+	c := client.NewClient(append(
+		opts,
+		client.WithMiddleware(apiKeyMiddleware),
+		client.WithMiddleware(jsonheader.New()),
+	)...)
+
+	// Randomize the order of API keys for balancing
+	apiKeyMiddleware.Shuffle()
+
+	// Return a new API instance with initialized client and resources
+	v := validation.New()
+
+	return &API{
+		client:           c,
+		apiKeys:          apiKeyMiddleware,
+		assets:           assets.New(c, v),
+		dataStores:       datastores.New(c, v),
+		memoryStore:      memorystore.New(c, v),
+		universes:        universes.New(c, v),
+		userRestrictions: userrestrictions.New(c, v),
+	}
+}
+
+// GetClient returns the Client instance used by the API.
+// This can be useful for advanced users who need direct access to the client.
+func (api *API) GetClient() *client.Client {
+	return api.client
+}
+
+// UpdateAPIKeys replaces the API keys requests are made with at runtime.
+func (api *API) UpdateAPIKeys(apiKeys []string) {
+	api.apiKeys.UpdateAPIKeys(apiKeys)
+}
+
+// Assets returns the Resource instance for asset-related operations.
+// This provides access to methods for uploading assets and waiting for their operations via the Open Cloud API.
+func (api *API) Assets() *assets.Resource {
+	return api.assets
+}
+
+// DataStores returns the Resource instance for data store-related operations.
+// This provides access to methods for reading and writing standard data stores via the Open Cloud API.
+func (api *API) DataStores() *datastores.Resource {
+	return api.dataStores
+}
+
+// MemoryStore returns the Resource instance for memory store-related operations.
+// This provides access to methods for using memory store sorted maps and queues via the Open Cloud API.
+func (api *API) MemoryStore() *memorystore.Resource {
+	return api.memoryStore
+}
+
+// Universes returns the Resource instance for universe-related operations.
+// This provides access to methods for messaging, restarting servers and sending notifications via the Open Cloud API.
+func (api *API) Universes() *universes.Resource {
+	return api.universes
+}
+
+// UserRestrictions returns the Resource instance for user restriction-related operations.
+// This provides access to methods for banning and unbanning users via the Open Cloud API.
+func (api *API) UserRestrictions() *userrestrictions.Resource {
+	return api.userRestrictions
+}
@@ -0,0 +1,145 @@
+package opencloud_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jaxron/axonet/middleware/retry"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/apikey"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// itemsResponse is a page of a list served by the test server.
+type itemsResponse struct {
+	Items         []string `json:"items"`
+	NextPageToken string   `json:"nextPageToken"`
+}
+
+// newCloudServer serves a paginated list, and errors in the current and legacy Open Cloud formats.
+func newCloudServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /items", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("pageToken") {
+		case "":
+			assert.Equal(t, "2", r.URL.Query().Get("maxPageSize"))
+			_, _ = w.Write([]byte(`{"items":["a","b"],"nextPageToken":"page2"}`))
+		case "page2":
+			_, _ = w.Write([]byte(`{"items":["c"],"nextPageToken":""}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	mux.HandleFunc("GET /keys", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"items":["` + r.Header.Get(apikey.HeaderAPIKey) + `"]}`))
+	})
+	mux.HandleFunc("GET /missing", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"NOT_FOUND","message":"Item not found.","details":[{"@type":"type.googleapis.com/example"}]}`))
+	})
+	mux.HandleFunc("GET /legacy", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"INVALID_ARGUMENT","message":"Invalid key.","errorDetails":[{"errorDetailType":"DatastoreErrorInfo"}]}`))
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestOpenCloud(t *testing.T) {
+	server := newCloudServer(t)
+	defer server.Close()
+
+	api := opencloud.New([]string{"key1", "key2"}, client.WithMiddleware(retry.New(1, 5000, 10000)))
+
+	fetch := func(ctx context.Context, p pagination.Params) ([]string, string, error) {
+		var page itemsResponse
+
+		resp, err := pagination.Query(api.GetClient().NewRequest().Method(http.MethodGet).URL(server.URL+"/items"), p).
+			Result(&page).
+			Do(ctx)
+		if err != nil {
+			return nil, "", errs.HandleCloudError(resp, err)
+		}
+
+		defer func() { _ = resp.Body.Close() }()
+
+		return page.Items, page.NextPageToken, nil
+	}
+
+	t.Run("Rotate API Keys", func(t *testing.T) {
+		keys := make([]string, 0, 2)
+
+		for range 2 {
+			var page itemsResponse
+
+			resp, err := api.GetClient().NewRequest().Method(http.MethodGet).URL(server.URL + "/keys").Result(&page).Do(context.Background())
+			require.NoError(t, err)
+
+			_ = resp.Body.Close()
+
+			keys = append(keys, page.Items...)
+		}
+
+		assert.ElementsMatch(t, []string{"key1", "key2"}, keys)
+	})
+
+	t.Run("Iterate Pages", func(t *testing.T) {
+		items := make([]string, 0, 3)
+
+		for item, err := range pagination.All(context.Background(), pagination.Params{MaxPageSize: 2}, fetch) {
+			require.NoError(t, err)
+
+			items = append(items, *item)
+		}
+
+		assert.Equal(t, []string{"a", "b", "c"}, items)
+	})
+
+	t.Run("Stop Iterating At Error", func(t *testing.T) {
+		count := 0
+
+		for item, err := range pagination.All(context.Background(), pagination.Params{PageToken: "expired"}, fetch) {
+			assert.Nil(t, item)
+			require.Error(t, err)
+
+			count++
+		}
+
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("Parse Cloud Errors", func(t *testing.T) {
+		resp, err := api.GetClient().NewRequest().Method(http.MethodGet).URL(server.URL + "/missing").Do(context.Background())
+		require.NotNil(t, resp)
+
+		defer func() { _ = resp.Body.Close() }()
+
+		var cloudErr *errs.CloudError
+		require.ErrorAs(t, errs.HandleCloudError(resp, err), &cloudErr)
+		assert.Equal(t, http.StatusNotFound, cloudErr.StatusCode)
+		assert.Equal(t, errs.CloudErrorNotFound, cloudErr.Code)
+		assert.Equal(t, "Item not found.", cloudErr.Message)
+		assert.Len(t, cloudErr.Details, 1)
+	})
+
+	t.Run("Parse Legacy Cloud Errors", func(t *testing.T) {
+		resp, err := api.GetClient().NewRequest().Method(http.MethodGet).URL(server.URL + "/legacy").Do(context.Background())
+		require.NotNil(t, resp)
+
+		defer func() { _ = resp.Body.Close() }()
+
+		var cloudErr *errs.CloudError
+		require.ErrorAs(t, errs.HandleCloudError(resp, err), &cloudErr)
+		assert.Equal(t, errs.CloudErrorInvalidArgument, cloudErr.Code)
+		assert.Equal(t, "Invalid key.", cloudErr.Message)
+		assert.JSONEq(t, `{"errorDetailType":"DatastoreErrorInfo"}`, string(cloudErr.Details[0]))
+	})
+}
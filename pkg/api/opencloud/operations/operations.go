@@ -0,0 +1,116 @@
+// Package operations implements polling of the long-running operations returned by Open Cloud methods.
+package operations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/internal/backoff"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+var (
+	ErrOperationFailed = errors.New("operation failed")
+	ErrNoResponse      = errors.New("operation finished without a response")
+)
+
+// Poller fetches the long-running operations of one Open Cloud API until they are done.
+// Operation paths are relative to the base URL of the API that returned them
+// (e.g., "operations/abc" for https://apis.roblox.com/assets/v1).
+type Poller[T any] struct {
+	client      *client.Client
+	validate    *validator.Validate
+	baseURL     string
+	minInterval time.Duration
+	maxInterval time.Duration
+}
+
+// NewPoller creates a new Poller for the operations of the API at the base URL.
+// It waits 1 second before the first poll, doubling the wait up to 30 seconds.
+func NewPoller[T any](client *client.Client, validate *validator.Validate, baseURL string) *Poller[T] {
+	return &Poller[T]{
+		client:      client,
+		validate:    validate,
+		baseURL:     baseURL,
+		minInterval: time.Second,
+		maxInterval: 30 * time.Second,
+	}
+}
+
+// SetBackoff sets the shortest and longest wait between polls.
+func (p *Poller[T]) SetBackoff(minInterval, maxInterval time.Duration) {
+	p.minInterval = minInterval
+	p.maxInterval = max(minInterval, maxInterval)
+}
+
+// Get fetches the current state of an operation.
+// GET {base_url}/{operation_path}
+func (p *Poller[T]) Get(ctx context.Context, path string) (*types.Operation[T], error) {
+	if err := p.validate.Var(path, "required"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var op types.Operation[T]
+
+	resp, err := p.client.NewRequest().
+		Method(http.MethodGet).
+		URL(p.baseURL + "/" + path).
+		Result(&op).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := p.validate.Struct(&op); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &op, nil
+}
+
+// Wait polls an operation with exponential backoff until it is done and returns its
+// response. Operations that are already done are not polled again.
+// If the operation failed, the error wraps ErrOperationFailed.
+func (p *Poller[T]) Wait(ctx context.Context, op *types.Operation[T]) (*T, error) {
+	delays := backoff.New(p.minInterval, p.maxInterval, 0.5)
+
+	for !op.Done {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delays.Delay()):
+		}
+
+		next, err := p.Get(ctx, op.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		op = next
+		delays.Increase()
+	}
+
+	return Result(op)
+}
+
+// Result returns the response of a finished operation, or an error wrapping
+// ErrOperationFailed if the operation failed.
+func Result[T any](op *types.Operation[T]) (*T, error) {
+	if op.Error != nil {
+		return nil, fmt.Errorf("%w: %s (code %d)", ErrOperationFailed, op.Error.Message, op.Error.Code)
+	}
+
+	if op.Response == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoResponse, op.Path)
+	}
+
+	return op.Response, nil
+}
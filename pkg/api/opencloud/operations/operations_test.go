@@ -0,0 +1,117 @@
+package operations_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/operations"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const baseURL = "https://apis.roblox.com/test/v1"
+
+// result is the response of the operations in the tests.
+type result struct {
+	Value string `json:"value"`
+}
+
+// newOperationServer serves operations that are done after the given number of polls,
+// then either succeed or fail.
+func newOperationServer(t *testing.T, pollsUntilDone int32, fail bool) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var polls atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /test/v1/operations/{id}", func(w http.ResponseWriter, r *http.Request) {
+		op := map[string]any{"path": "operations/" + r.PathValue("id"), "done": polls.Add(1) >= pollsUntilDone}
+
+		if op["done"] == true {
+			if fail {
+				op["error"] = map[string]any{"code": 3, "message": "Invalid file."}
+			} else {
+				op["response"] = map[string]any{"value": r.PathValue("id")}
+			}
+		}
+
+		utils.WriteJSON(w, op)
+	})
+
+	return httptest.NewServer(mux), &polls
+}
+
+func TestPoller(t *testing.T) {
+	ctx := context.Background()
+
+	newPoller := func(server *httptest.Server) *operations.Poller[result] {
+		c, v := utils.NewLocalTestEnv(server.URL)
+
+		poller := operations.NewPoller[result](c, v, baseURL)
+		poller.SetBackoff(time.Millisecond, 5*time.Millisecond)
+
+		return poller
+	}
+
+	t.Run("Wait Until Done", func(t *testing.T) {
+		server, polls := newOperationServer(t, 3, false)
+		defer server.Close()
+
+		res, err := newPoller(server).Wait(ctx, &types.Operation[result]{Path: "operations/abc"})
+		require.NoError(t, err)
+		assert.Equal(t, "abc", res.Value)
+		assert.Equal(t, int32(3), polls.Load())
+	})
+
+	t.Run("Skip Polling Done Operations", func(t *testing.T) {
+		server, polls := newOperationServer(t, 1, false)
+		defer server.Close()
+
+		op := &types.Operation[result]{Path: "operations/abc", Done: true, Response: &result{Value: "done"}}
+
+		res, err := newPoller(server).Wait(ctx, op)
+		require.NoError(t, err)
+		assert.Equal(t, "done", res.Value)
+		assert.Zero(t, polls.Load())
+	})
+
+	t.Run("Report Failed Operations", func(t *testing.T) {
+		server, _ := newOperationServer(t, 2, true)
+		defer server.Close()
+
+		_, err := newPoller(server).Wait(ctx, &types.Operation[result]{Path: "operations/abc"})
+		require.ErrorIs(t, err, operations.ErrOperationFailed)
+		assert.Contains(t, err.Error(), "Invalid file.")
+	})
+
+	t.Run("Stop When Context Is Done", func(t *testing.T) {
+		server, _ := newOperationServer(t, 1000, false)
+		defer server.Close()
+
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		_, err := newPoller(server).Wait(ctx, &types.Operation[result]{Path: "operations/abc"})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("Missing Response", func(t *testing.T) {
+		_, err := operations.Result(&types.Operation[result]{Path: "operations/abc", Done: true})
+		require.ErrorIs(t, err, operations.ErrNoResponse)
+	})
+
+	t.Run("Invalid Path", func(t *testing.T) {
+		server, _ := newOperationServer(t, 1, false)
+		defer server.Close()
+
+		_, err := newPoller(server).Get(ctx, "")
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+	})
+}
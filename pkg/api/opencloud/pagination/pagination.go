@@ -0,0 +1,59 @@
+// Package pagination implements the page token pagination shared by Open Cloud list methods.
+package pagination
+
+import (
+	"context"
+	"iter"
+	"strconv"
+
+	"github.com/jaxron/axonet/pkg/client"
+)
+
+// Params holds the pagination parameters of an Open Cloud list request.
+type Params struct {
+	MaxPageSize int64  `json:"maxPageSize" validate:"min=0"` // Maximum number of items per page, or 0 for the server default
+	PageToken   string `json:"pageToken"`                    // Token of the page to fetch, or empty for the first page
+}
+
+// Query adds the pagination parameters that are set to the request.
+func Query(req *client.Request, p Params) *client.Request {
+	if p.MaxPageSize > 0 {
+		req = req.Query("maxPageSize", strconv.FormatInt(p.MaxPageSize, 10))
+	}
+
+	if p.PageToken != "" {
+		req = req.Query("pageToken", p.PageToken)
+	}
+
+	return req
+}
+
+// FetchFunc fetches the page of the given parameters and returns its items and the next page token.
+type FetchFunc[T any] func(ctx context.Context, p Params) ([]T, string, error)
+
+// All returns an iterator over every item of a list, starting at the page token in the
+// parameters and following next page tokens until the last page.
+// Iteration stops at the first error, which is yielded with a nil item.
+func All[T any](ctx context.Context, p Params, fetch FetchFunc[T]) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		for {
+			items, nextPageToken, err := fetch(ctx, p)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for i := range items {
+				if !yield(&items[i], nil) {
+					return
+				}
+			}
+
+			if nextPageToken == "" || nextPageToken == p.PageToken {
+				return
+			}
+
+			p.PageToken = nextPageToken
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/pagination"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// versionsURL returns the URL of the versions of an asset.
+func versionsURL(assetID int64) string {
+	return fmt.Sprintf("%s/assets/%d/versions", types.OpenCloudAssetsEndpoint, assetID)
+}
+
+// GetAssetVersion fetches a single version of an asset.
+// GET https://apis.roblox.com/assets/v1/assets/{asset_id}/versions/{version_number}
+func (r *Resource) GetAssetVersion(ctx context.Context, p AssetVersionParams) (*types.CloudAssetVersion, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var version types.CloudAssetVersion
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/%d", versionsURL(p.AssetID), p.VersionNumber)).
+		Result(&version).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&version); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &version, nil
+}
+
+// ListAssetVersions fetches a page of the versions of an asset, newest first.
+// GET https://apis.roblox.com/assets/v1/assets/{asset_id}/versions
+func (r *Resource) ListAssetVersions(ctx context.Context, p AssetVersionsParams) (*types.CloudAssetVersionsResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var versions types.CloudAssetVersionsResponse
+
+	req := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(versionsURL(p.AssetID))
+
+	resp, err := pagination.Query(req, p.Params).
+		Result(&versions).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&versions); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &versions, nil
+}
+
+// ListAssetVersionsAll returns an iterator over every version of an asset,
+// starting at the page token in the parameters and following pages until the last one.
+// Iteration stops at the first error, which is yielded with a nil version.
+func (r *Resource) ListAssetVersionsAll(ctx context.Context, p AssetVersionsParams) iter.Seq2[*types.CloudAssetVersion, error] {
+	return pagination.All(ctx, p.Params, func(ctx context.Context, page pagination.Params) ([]types.CloudAssetVersion, string, error) {
+		p.Params = page
+
+		result, err := r.ListAssetVersions(ctx, p)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return result.AssetVersions, result.NextPageToken, nil
+	})
+}
+
+// AssetVersionParams identifies a version of an asset.
+type AssetVersionParams struct {
+	AssetID       int64 `json:"assetId"       validate:"required,gt=0"` // ID of the asset
+	VersionNumber int64 `json:"versionNumber" validate:"required,gt=0"` // Number of the version, starting at 1
+}
+
+// AssetVersionBuilder is a builder for AssetVersionParams.
+type AssetVersionBuilder struct {
+	params AssetVersionParams
+}
+
+// NewAssetVersionBuilder creates a new AssetVersionBuilder with default values.
+func NewAssetVersionBuilder(assetID, versionNumber int64) *AssetVersionBuilder {
+	return &AssetVersionBuilder{
+		params: AssetVersionParams{
+			AssetID:       assetID,
+			VersionNumber: versionNumber,
+		},
+	}
+}
+
+// Build returns the AssetVersionParams.
+func (b *AssetVersionBuilder) Build() AssetVersionParams {
+	return b.params
+}
+
+// AssetVersionsParams holds the parameters for listing the versions of an asset.
+type AssetVersionsParams struct {
+	pagination.Params
+
+	AssetID int64 `json:"assetId" validate:"required,gt=0"` // ID of the asset
+}
+
+// AssetVersionsBuilder is a builder for AssetVersionsParams.
+type AssetVersionsBuilder struct {
+	params AssetVersionsParams
+}
+
+// NewAssetVersionsBuilder creates a new AssetVersionsBuilder with default values.
+func NewAssetVersionsBuilder(assetID int64) *AssetVersionsBuilder {
+	return &AssetVersionsBuilder{
+		params: AssetVersionsParams{
+			Params:  pagination.Params{MaxPageSize: 10, PageToken: ""},
+			AssetID: assetID,
+		},
+	}
+}
+
+// WithMaxPageSize sets the maximum number of versions per page.
+func (b *AssetVersionsBuilder) WithMaxPageSize(maxPageSize int64) *AssetVersionsBuilder {
+	b.params.MaxPageSize = maxPageSize
+	return b
+}
+
+// WithPageToken sets the token of the page to fetch.
+func (b *AssetVersionsBuilder) WithPageToken(pageToken string) *AssetVersionsBuilder {
+	b.params.PageToken = pageToken
+	return b
+}
+
+// Build returns the AssetVersionsParams.
+func (b *AssetVersionsBuilder) Build() AssetVersionsParams {
+	return b.params
+}
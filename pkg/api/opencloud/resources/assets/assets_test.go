@@ -0,0 +1,342 @@
+package assets_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/resources/assets"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const userID = int64(100)
+
+// assetServer is an in-memory stand-in for the Open Cloud asset endpoints.
+// Operations are done the first time they are polled.
+type assetServer struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	assets     map[int64]*types.CloudAsset
+	versions   map[int64][]types.CloudAssetVersion // Versions of every asset, oldest first
+	contents   map[int64][]string                  // Content types of every version, oldest first
+	operations map[string]int64                    // Asset ID of every operation
+	nextID     int64
+}
+
+func newAssetServer(t *testing.T) *assetServer {
+	t.Helper()
+
+	s := &assetServer{
+		assets:     make(map[int64]*types.CloudAsset),
+		versions:   make(map[int64][]types.CloudAssetVersion),
+		contents:   make(map[int64][]string),
+		operations: make(map[string]int64),
+		nextID:     1000,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /assets/v1/assets", s.createAsset)
+	mux.HandleFunc("PATCH /assets/v1/assets/{asset}", s.updateAsset)
+	mux.HandleFunc("GET /assets/v1/assets/{asset}", func(w http.ResponseWriter, r *http.Request) {
+		if asset := s.asset(w, r); asset != nil {
+			utils.WriteJSON(w, asset)
+		}
+	})
+	mux.HandleFunc("GET /assets/v1/assets/{asset}/versions", s.listVersions)
+	mux.HandleFunc("GET /assets/v1/assets/{asset}/versions/{version}", func(w http.ResponseWriter, r *http.Request) {
+		if s.asset(w, r) == nil {
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		assetID, _ := strconv.ParseInt(r.PathValue("asset"), 10, 64)
+		number, _ := strconv.Atoi(r.PathValue("version"))
+
+		if number < 1 || number > len(s.versions[assetID]) {
+			utils.WriteCloudError(w, http.StatusNotFound, "NOT_FOUND", "Version not found.")
+			return
+		}
+
+		utils.WriteJSON(w, s.versions[assetID][number-1])
+	})
+	mux.HandleFunc("GET /assets/v1/operations/{operation}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		path := "operations/" + r.PathValue("operation")
+		utils.WriteJSON(w, types.CloudAssetOperation{Path: path, Done: true, Response: s.assets[s.operations[path]]})
+	})
+
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+// readForm parses the multipart request and returns its metadata and the content type of its file.
+func readForm(r *http.Request, request any) (string, error) {
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		return "", err
+	}
+
+	if err := json.Unmarshal([]byte(r.FormValue("request")), request); err != nil {
+		return "", err
+	}
+
+	file, header, err := r.FormFile("fileContent")
+	if err != nil {
+		return "", nil //nolint:nilerr // The file is optional
+	}
+
+	defer func() { _ = file.Close() }()
+
+	content, err := io.ReadAll(file)
+	if err != nil || len(content) == 0 {
+		return "", fmt.Errorf("empty file: %w", err)
+	}
+
+	return header.Header.Get("Content-Type"), nil
+}
+
+func (s *assetServer) createAsset(w http.ResponseWriter, r *http.Request) {
+	var request types.CloudAsset
+
+	contentType, err := readForm(r, &request)
+	if err != nil || contentType == "" {
+		utils.WriteCloudError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "Invalid upload.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	request.AssetID = s.nextID
+	request.Path = fmt.Sprintf("assets/%d", request.AssetID)
+	request.ModerationResult.ModerationState = types.CloudAssetModerationStateApproved
+	s.assets[request.AssetID] = &request
+
+	s.addVersion(request.AssetID, contentType)
+	utils.WriteJSON(w, s.operation(request.AssetID))
+}
+
+func (s *assetServer) updateAsset(w http.ResponseWriter, r *http.Request) {
+	asset := s.asset(w, r)
+	if asset == nil {
+		return
+	}
+
+	var request types.CloudAsset
+
+	contentType, err := readForm(r, &request)
+	if err != nil || request.AssetID != asset.AssetID {
+		utils.WriteCloudError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "Invalid update.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mask := strings.Split(r.URL.Query().Get("updateMask"), ",")
+	if slices.Contains(mask, "displayName") {
+		asset.DisplayName = request.DisplayName
+	}
+
+	if slices.Contains(mask, "description") {
+		asset.Description = request.Description
+	}
+
+	if contentType != "" {
+		s.addVersion(asset.AssetID, contentType)
+	}
+
+	utils.WriteJSON(w, s.operation(asset.AssetID))
+}
+
+func (s *assetServer) listVersions(w http.ResponseWriter, r *http.Request) {
+	if s.asset(w, r) == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	assetID, _ := strconv.ParseInt(r.PathValue("asset"), 10, 64)
+	versions := slices.Clone(s.versions[assetID])
+	slices.Reverse(versions)
+
+	start, _ := strconv.Atoi(r.URL.Query().Get("pageToken"))
+	size, _ := strconv.Atoi(r.URL.Query().Get("maxPageSize"))
+	end := min(start+size, len(versions))
+
+	page := types.CloudAssetVersionsResponse{AssetVersions: versions[start:end]}
+	if end < len(versions) {
+		page.NextPageToken = strconv.Itoa(end)
+	}
+
+	utils.WriteJSON(w, page)
+}
+
+// asset returns the asset of the request, or writes an error and returns nil if it does not exist.
+func (s *assetServer) asset(w http.ResponseWriter, r *http.Request) *types.CloudAsset {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	assetID, _ := strconv.ParseInt(r.PathValue("asset"), 10, 64)
+
+	asset, ok := s.assets[assetID]
+	if !ok {
+		utils.WriteCloudError(w, http.StatusNotFound, "NOT_FOUND", "Asset not found.")
+		return nil
+	}
+
+	return asset
+}
+
+func (s *assetServer) addVersion(assetID int64, contentType string) {
+	for i := range s.versions[assetID] {
+		s.versions[assetID][i].Published = false
+	}
+
+	s.versions[assetID] = append(s.versions[assetID], types.CloudAssetVersion{
+		Path:            fmt.Sprintf("assets/%d/versions/%d", assetID, len(s.versions[assetID])+1),
+		CreationContext: s.assets[assetID].CreationContext,
+		Published:       true,
+	})
+	s.contents[assetID] = append(s.contents[assetID], contentType)
+}
+
+func (s *assetServer) operation(assetID int64) types.CloudAssetOperation {
+	path := fmt.Sprintf("operations/%d-%d", assetID, len(s.operations))
+	s.operations[path] = assetID
+
+	return types.CloudAssetOperation{Path: path}
+}
+
+func TestAssets(t *testing.T) {
+	server := newAssetServer(t)
+	defer server.Close()
+
+	api := assets.New(utils.NewLocalTestEnv(server.URL))
+	api.Poller().SetBackoff(time.Millisecond, time.Millisecond)
+
+	ctx := context.Background()
+
+	var assetID int64
+
+	t.Run("Create Asset", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "Sword.rbxm")
+		require.NoError(t, os.WriteFile(path, []byte("<roblox!"), 0o600))
+
+		file, err := assets.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "Sword.rbxm", file.Name)
+		assert.Equal(t, "model/x-rbxm", file.ContentType)
+
+		params := assets.NewCreateAssetBuilder(types.CloudAssetTypeModel, "Sword", userID, file).
+			WithDescription("A sharp sword").
+			Build()
+
+		op, err := api.CreateAsset(ctx, params)
+		require.NoError(t, err)
+		assert.False(t, op.Done)
+
+		asset, err := api.Wait(ctx, op)
+		require.NoError(t, err)
+		assert.Equal(t, types.CloudAssetTypeModel, asset.AssetType)
+		assert.Equal(t, "Sword", asset.DisplayName)
+		assert.Equal(t, "A sharp sword", asset.Description)
+		assert.Equal(t, userID, asset.CreationContext.Creator.UserID)
+
+		assetID = asset.AssetID
+	})
+
+	t.Run("Update Asset", func(t *testing.T) {
+		params := assets.NewUpdateAssetBuilder(assetID).
+			WithDisplayName("Great Sword").
+			WithFile(assets.NewFile("Sword.fbx", []byte("Kaydara FBX Binary"))).
+			Build()
+
+		op, err := api.UpdateAsset(ctx, params)
+		require.NoError(t, err)
+
+		asset, err := api.Wait(ctx, op)
+		require.NoError(t, err)
+		assert.Equal(t, "Great Sword", asset.DisplayName)
+		assert.Equal(t, "A sharp sword", asset.Description)
+		assert.Equal(t, []string{"model/x-rbxm", "model/fbx"}, server.contents[assetID])
+
+		fetched, err := api.GetAsset(ctx, assetID)
+		require.NoError(t, err)
+		assert.Equal(t, "Great Sword", fetched.DisplayName)
+	})
+
+	t.Run("Get Asset Versions", func(t *testing.T) {
+		version, err := api.GetAssetVersion(ctx, assets.NewAssetVersionBuilder(assetID, 1).Build())
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), version.VersionNumber())
+		assert.False(t, version.Published)
+
+		var numbers []int64
+
+		for version, err := range api.ListAssetVersionsAll(ctx, assets.NewAssetVersionsBuilder(assetID).WithMaxPageSize(1).Build()) {
+			require.NoError(t, err)
+
+			numbers = append(numbers, version.VersionNumber())
+		}
+
+		assert.Equal(t, []int64{2, 1}, numbers)
+	})
+
+	t.Run("Group Owned Asset", func(t *testing.T) {
+		params := assets.NewCreateAssetBuilder(types.CloudAssetTypeDecal, "Logo", userID, assets.NewFile("logo.PNG", []byte("png"))).
+			WithGroupID(7).
+			Build()
+
+		op, err := api.CreateAsset(ctx, params)
+		require.NoError(t, err)
+
+		asset, err := api.Wait(ctx, op)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), asset.CreationContext.Creator.GroupID)
+		assert.Zero(t, asset.CreationContext.Creator.UserID)
+	})
+
+	t.Run("Unknown Asset", func(t *testing.T) {
+		_, err := api.GetAsset(ctx, 1)
+
+		var cloudErr *errs.CloudError
+		require.ErrorAs(t, err, &cloudErr)
+		assert.Equal(t, errs.CloudErrorNotFound, cloudErr.Code)
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		_, err := api.CreateAsset(ctx, assets.NewCreateAssetBuilder(types.CloudAssetTypeModel, "Sword", userID, assets.NewFile("sword.exe", []byte("MZ"))).Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "ContentType")
+
+		_, err = api.CreateAsset(ctx, assets.NewCreateAssetBuilder(types.CloudAssetTypeModel, "Sword", 0, assets.NewFile("sword.rbxm", []byte("x"))).Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "UserID")
+
+		_, err = api.UpdateAsset(ctx, assets.NewUpdateAssetBuilder(assetID).Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+	})
+}
@@ -0,0 +1,115 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// CreateAsset uploads a new asset. The upload is processed in the background; pass the
+// returned operation to Wait to get the asset once it is created.
+// POST https://apis.roblox.com/assets/v1/assets
+func (r *Resource) CreateAsset(ctx context.Context, p CreateAssetParams) (*types.CloudAssetOperation, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	body, contentType, err := multipartBody(struct {
+		AssetType       types.CloudAssetType            `json:"assetType"`
+		DisplayName     string                          `json:"displayName"`
+		Description     string                          `json:"description"`
+		CreationContext types.CloudAssetCreationContext `json:"creationContext"`
+	}{
+		AssetType:   p.AssetType,
+		DisplayName: p.DisplayName,
+		Description: p.Description,
+		CreationContext: types.CloudAssetCreationContext{
+			Creator:       types.CloudAssetCreator{UserID: p.UserID, GroupID: p.GroupID},
+			ExpectedPrice: p.ExpectedPrice,
+		},
+	}, &p.File)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var op types.CloudAssetOperation
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(types.OpenCloudAssetsEndpoint+"/assets").
+		Header("Content-Type", contentType).
+		Body(body).
+		Result(&op).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&op); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &op, nil
+}
+
+// CreateAssetParams holds the parameters for uploading a new asset.
+type CreateAssetParams struct {
+	AssetType     types.CloudAssetType `json:"assetType"     validate:"required,oneof=Audio Decal Image Model"`               // Type of the asset
+	DisplayName   string               `json:"displayName"   validate:"required,max=50"`                                      // Name of the asset
+	Description   string               `json:"description"   validate:"max=1000"`                                             // Description of the asset
+	UserID        int64                `json:"userId"        validate:"required_without=GroupID,excluded_with=GroupID,gte=0"` // ID of the user to own the asset
+	GroupID       int64                `json:"groupId"       validate:"omitempty,gt=0"`                                       // ID of the group to own the asset
+	ExpectedPrice int64                `json:"expectedPrice" validate:"min=0"`                                                // Robux the creator expects to pay, rejecting uploads that cost more
+	File          File                 `json:"file"`                                                                          // Content of the asset
+}
+
+// CreateAssetBuilder is a builder for CreateAssetParams.
+type CreateAssetBuilder struct {
+	params CreateAssetParams
+}
+
+// NewCreateAssetBuilder creates a new CreateAssetBuilder with default values.
+// The asset is owned by a user unless WithGroupID is used instead.
+func NewCreateAssetBuilder(assetType types.CloudAssetType, displayName string, userID int64, file File) *CreateAssetBuilder {
+	return &CreateAssetBuilder{
+		params: CreateAssetParams{
+			AssetType:     assetType,
+			DisplayName:   displayName,
+			Description:   "",
+			UserID:        userID,
+			GroupID:       0,
+			ExpectedPrice: 0,
+			File:          file,
+		},
+	}
+}
+
+// WithDescription sets the description of the asset.
+func (b *CreateAssetBuilder) WithDescription(description string) *CreateAssetBuilder {
+	b.params.Description = description
+	return b
+}
+
+// WithGroupID makes the asset owned by a group instead of a user.
+func (b *CreateAssetBuilder) WithGroupID(groupID int64) *CreateAssetBuilder {
+	b.params.UserID = 0
+	b.params.GroupID = groupID
+
+	return b
+}
+
+// WithExpectedPrice sets the Robux the creator expects to pay for the upload.
+func (b *CreateAssetBuilder) WithExpectedPrice(expectedPrice int64) *CreateAssetBuilder {
+	b.params.ExpectedPrice = expectedPrice
+	return b
+}
+
+// Build returns the CreateAssetParams.
+func (b *CreateAssetBuilder) Build() CreateAssetParams {
+	return b.params
+}
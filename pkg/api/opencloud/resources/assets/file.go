@@ -0,0 +1,95 @@
+package assets
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// contentTypes maps the file extensions that can be uploaded to their content types.
+var contentTypes = map[string]string{
+	".fbx":   "model/fbx",
+	".gltf":  "model/gltf+json",
+	".glb":   "model/gltf-binary",
+	".rbxm":  "model/x-rbxm",
+	".rbxmx": "model/x-rbxmx",
+	".png":   "image/png",
+	".jpg":   "image/jpeg",
+	".jpeg":  "image/jpeg",
+	".bmp":   "image/bmp",
+	".tga":   "image/tga",
+	".mp3":   "audio/mpeg",
+	".ogg":   "audio/ogg",
+	".wav":   "audio/wav",
+	".flac":  "audio/flac",
+}
+
+// File is the content of an asset to upload.
+type File struct {
+	Name        string `json:"name"        validate:"required"` // File name, including the extension
+	ContentType string `json:"contentType" validate:"required"` // Content type of the file (e.g., "model/fbx")
+	Content     []byte `json:"-"           validate:"required"` // Content of the file
+}
+
+// NewFile creates a File, with the content type inferred from the extension of the name.
+// Files with unknown extensions get an empty content type, which fails validation.
+func NewFile(name string, content []byte) File {
+	return File{
+		Name:        filepath.Base(name),
+		ContentType: contentTypes[strings.ToLower(filepath.Ext(name))],
+		Content:     content,
+	}
+}
+
+// ReadFile reads the file at the path into a File.
+func ReadFile(path string) (File, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return File{}, err
+	}
+
+	return NewFile(path, content), nil
+}
+
+// multipartBody encodes the request metadata and an optional file as a multipart form.
+// It returns the body and its content type.
+func multipartBody(request any, file *File) ([]byte, string, error) {
+	var buf bytes.Buffer
+
+	writer := multipart.NewWriter(&buf)
+
+	encoded, err := json.Marshal(request)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := writer.WriteField("request", string(encoded)); err != nil {
+		return nil, "", err
+	}
+
+	if file != nil {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="fileContent"; filename=%q`, file.Name))
+		header.Set("Content-Type", file.ContentType)
+
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if _, err := part.Write(file.Content); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return buf.Bytes(), writer.FormDataContentType(), nil
+}
@@ -0,0 +1,38 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetAsset fetches the metadata of an asset.
+// GET https://apis.roblox.com/assets/v1/assets/{asset_id}
+func (r *Resource) GetAsset(ctx context.Context, assetID int64) (*types.CloudAsset, error) {
+	if err := r.validate.Var(assetID, "required,gt=0"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var asset types.CloudAsset
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(types.OpenCloudAssetsEndpoint + "/assets/" + strconv.FormatInt(assetID, 10)).
+		Result(&asset).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&asset); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &asset, nil
+}
@@ -0,0 +1,58 @@
+package assets
+
+import (
+	"context"
+	"iter"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/operations"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ResourceInterface defines the interface for asset-related operations.
+type ResourceInterface interface {
+	CreateAsset(ctx context.Context, p CreateAssetParams) (*types.CloudAssetOperation, error)
+	UpdateAsset(ctx context.Context, p UpdateAssetParams) (*types.CloudAssetOperation, error)
+	GetAsset(ctx context.Context, assetID int64) (*types.CloudAsset, error)
+	GetAssetVersion(ctx context.Context, p AssetVersionParams) (*types.CloudAssetVersion, error)
+	ListAssetVersions(ctx context.Context, p AssetVersionsParams) (*types.CloudAssetVersionsResponse, error)
+	ListAssetVersionsAll(ctx context.Context, p AssetVersionsParams) iter.Seq2[*types.CloudAssetVersion, error]
+	GetOperation(ctx context.Context, path string) (*types.CloudAssetOperation, error)
+	Wait(ctx context.Context, op *types.CloudAssetOperation) (*types.CloudAsset, error)
+}
+
+// Ensure Resource implements the ResourceInterface.
+var _ ResourceInterface = (*Resource)(nil)
+
+// Resource provides methods for interacting with the Open Cloud asset endpoints.
+type Resource struct {
+	client   *client.Client
+	validate *validator.Validate
+	poller   *operations.Poller[types.CloudAsset]
+}
+
+// New creates a new Resource with the specified client and validator.
+func New(client *client.Client, validate *validator.Validate) *Resource {
+	return &Resource{
+		client:   client,
+		validate: validate,
+		poller:   operations.NewPoller[types.CloudAsset](client, validate, types.OpenCloudAssetsEndpoint),
+	}
+}
+
+// Poller returns the poller used by Wait, so that its backoff can be adjusted.
+func (r *Resource) Poller() *operations.Poller[types.CloudAsset] {
+	return r.poller
+}
+
+// GetOperation fetches the current state of an asset operation.
+// GET https://apis.roblox.com/assets/v1/operations/{operation_id}
+func (r *Resource) GetOperation(ctx context.Context, path string) (*types.CloudAssetOperation, error) {
+	return r.poller.Get(ctx, path)
+}
+
+// Wait polls an asset operation until it is done and returns the created or updated asset.
+func (r *Resource) Wait(ctx context.Context, op *types.CloudAssetOperation) (*types.CloudAsset, error) {
+	return r.poller.Wait(ctx, op)
+}
@@ -0,0 +1,122 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// UpdateAsset changes the name or description of an asset, or uploads a new version of
+// its content. Only the fields that are set are changed. Like CreateAsset, the update is
+// processed in the background; pass the returned operation to Wait to get the asset.
+// PATCH https://apis.roblox.com/assets/v1/assets/{asset_id}
+func (r *Resource) UpdateAsset(ctx context.Context, p UpdateAssetParams) (*types.CloudAssetOperation, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var mask []string
+	if p.Description != "" {
+		mask = append(mask, "description")
+	}
+
+	if p.DisplayName != "" {
+		mask = append(mask, "displayName")
+	}
+
+	if len(mask) == 0 && p.File == nil {
+		return nil, fmt.Errorf("%w: nothing to update", errs.ErrInvalidRequest)
+	}
+
+	body, contentType, err := multipartBody(struct {
+		AssetID     int64  `json:"assetId,string"`
+		DisplayName string `json:"displayName,omitempty"`
+		Description string `json:"description,omitempty"`
+	}{
+		AssetID:     p.AssetID,
+		DisplayName: p.DisplayName,
+		Description: p.Description,
+	}, p.File)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var op types.CloudAssetOperation
+
+	req := r.client.NewRequest().
+		Method(http.MethodPatch).
+		URL(types.OpenCloudAssetsEndpoint+"/assets/"+strconv.FormatInt(p.AssetID, 10)).
+		Header("Content-Type", contentType).
+		Body(body)
+	if len(mask) > 0 {
+		req = req.Query("updateMask", strings.Join(mask, ","))
+	}
+
+	resp, err := req.
+		Result(&op).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&op); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &op, nil
+}
+
+// UpdateAssetParams holds the parameters for updating an asset.
+type UpdateAssetParams struct {
+	AssetID     int64  `json:"assetId"     validate:"required,gt=0"` // ID of the asset
+	DisplayName string `json:"displayName" validate:"max=50"`        // New name of the asset, or empty to keep it
+	Description string `json:"description" validate:"max=1000"`      // New description of the asset, or empty to keep it
+	File        *File  `json:"file"        validate:"omitempty"`     // New content of the asset, or nil to keep it
+}
+
+// UpdateAssetBuilder is a builder for UpdateAssetParams.
+type UpdateAssetBuilder struct {
+	params UpdateAssetParams
+}
+
+// NewUpdateAssetBuilder creates a new UpdateAssetBuilder with default values.
+func NewUpdateAssetBuilder(assetID int64) *UpdateAssetBuilder {
+	return &UpdateAssetBuilder{
+		params: UpdateAssetParams{
+			AssetID:     assetID,
+			DisplayName: "",
+			Description: "",
+			File:        nil,
+		},
+	}
+}
+
+// WithDisplayName sets the new name of the asset.
+func (b *UpdateAssetBuilder) WithDisplayName(displayName string) *UpdateAssetBuilder {
+	b.params.DisplayName = displayName
+	return b
+}
+
+// WithDescription sets the new description of the asset.
+func (b *UpdateAssetBuilder) WithDescription(description string) *UpdateAssetBuilder {
+	b.params.Description = description
+	return b
+}
+
+// WithFile sets the new content of the asset.
+func (b *UpdateAssetBuilder) WithFile(file File) *UpdateAssetBuilder {
+	b.params.File = &file
+	return b
+}
+
+// Build returns the UpdateAssetParams.
+func (b *UpdateAssetBuilder) Build() UpdateAssetParams {
+	return b.params
+}
@@ -0,0 +1,92 @@
+package datastores
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// CreateEntry creates a data store entry. It fails if the entry already exists.
+// POST https://apis.roblox.com/cloud/v2/universes/{universe_id}/data-stores/{data_store_id}/entries
+func (r *Resource) CreateEntry(ctx context.Context, p CreateEntryParams) (*types.DataStoreEntry, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	if !json.Valid(p.Value) {
+		return nil, fmt.Errorf("%w: value is not valid JSON", errs.ErrInvalidRequest)
+	}
+
+	var entry types.DataStoreEntry
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(dataStoreURL(p.UniverseID, p.DataStoreID, p.Scope)+"/entries").
+		Query("id", p.EntryID).
+		MarshalBody(entryBody{
+			Value:      p.Value,
+			Users:      userPaths(p.UserIDs),
+			Attributes: p.Attributes,
+			Etag:       "",
+		}).
+		Result(&entry).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&entry); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &entry, nil
+}
+
+// CreateEntryParams holds the parameters for creating a data store entry.
+type CreateEntryParams struct {
+	EntryParams
+
+	Value      json.RawMessage `json:"value"      validate:"required"`        // JSON value of the entry
+	UserIDs    []int64         `json:"userIds"    validate:"max=4,dive,gt=0"` // IDs of the users associated with the entry
+	Attributes map[string]any  `json:"attributes"`                            // Custom metadata of the entry
+}
+
+// CreateEntryBuilder is a builder for CreateEntryParams.
+type CreateEntryBuilder struct {
+	params CreateEntryParams
+}
+
+// NewCreateEntryBuilder creates a new CreateEntryBuilder with default values.
+func NewCreateEntryBuilder(entry EntryParams, value json.RawMessage) *CreateEntryBuilder {
+	return &CreateEntryBuilder{
+		params: CreateEntryParams{
+			EntryParams: entry,
+			Value:       value,
+			UserIDs:     nil,
+			Attributes:  nil,
+		},
+	}
+}
+
+// WithUserIDs sets the IDs of the users associated with the entry.
+func (b *CreateEntryBuilder) WithUserIDs(userIDs ...int64) *CreateEntryBuilder {
+	b.params.UserIDs = userIDs
+	return b
+}
+
+// WithAttributes sets the custom metadata of the entry.
+func (b *CreateEntryBuilder) WithAttributes(attributes map[string]any) *CreateEntryBuilder {
+	b.params.Attributes = attributes
+	return b
+}
+
+// Build returns the CreateEntryParams.
+func (b *CreateEntryBuilder) Build() CreateEntryParams {
+	return b.params
+}
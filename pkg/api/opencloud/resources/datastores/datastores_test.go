@@ -0,0 +1,387 @@
+package datastores_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/apikey"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/resources/datastores"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const universeID = int64(1)
+
+// dataStoreServer is an in-memory stand-in for the Open Cloud data store endpoints.
+type dataStoreServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	entries map[string][]types.DataStoreEntry // Revisions of every entry path, oldest first
+}
+
+func newDataStoreServer(t *testing.T) *dataStoreServer {
+	t.Helper()
+
+	s := &dataStoreServer{entries: make(map[string][]types.DataStoreEntry)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(apikey.HeaderAPIKey) != utils.LocalTestAPIKey {
+			utils.WriteCloudError(w, http.StatusUnauthorized, "UNAUTHENTICATED", "Invalid API key.")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.serve(w, r)
+	}))
+
+	return s
+}
+
+// serve routes a request by its path, as the entry methods are suffixes of the last segment.
+func (s *dataStoreServer) serve(w http.ResponseWriter, r *http.Request) {
+	prefix := fmt.Sprintf("/cloud/v2/universes/%d/data-stores", universeID)
+	path := strings.TrimPrefix(r.URL.Path, prefix)
+
+	if path == "" && r.Method == http.MethodGet {
+		s.listDataStores(w, r)
+		return
+	}
+
+	dataStore, entryPath, found := strings.Cut(strings.TrimPrefix(path, "/"), "/entries")
+	if !found {
+		utils.WriteCloudError(w, http.StatusNotFound, "NOT_FOUND", "Unknown path.")
+		return
+	}
+
+	if entryPath == "" {
+		switch r.Method {
+		case http.MethodGet:
+			s.listEntries(w, r, dataStore)
+		case http.MethodPost:
+			s.writeEntry(w, r, dataStore+"/entries/"+r.URL.Query().Get("id"), "create")
+		}
+
+		return
+	}
+
+	key := dataStore + "/entries" + entryPath
+
+	switch {
+	case strings.HasSuffix(key, ":increment"):
+		s.writeEntry(w, r, strings.TrimSuffix(key, ":increment"), "increment")
+	case strings.HasSuffix(key, ":listRevisions"):
+		revisions := slices.Clone(s.entries[strings.TrimSuffix(key, ":listRevisions")])
+		slices.Reverse(revisions)
+		utils.WriteJSON(w, types.DataStoreEntriesResponse{DataStoreEntries: revisions})
+	case strings.Contains(key, "@"):
+		key, revisionID, _ := strings.Cut(key, "@")
+		for _, revision := range s.entries[key] {
+			if revision.RevisionID == revisionID {
+				utils.WriteJSON(w, revision)
+				return
+			}
+		}
+
+		utils.WriteCloudError(w, http.StatusNotFound, "NOT_FOUND", "Revision not found.")
+	case r.Method == http.MethodGet:
+		if latest, ok := s.latest(key); ok {
+			utils.WriteJSON(w, latest)
+			return
+		}
+
+		utils.WriteCloudError(w, http.StatusNotFound, "NOT_FOUND", "Entry not found.")
+	case r.Method == http.MethodPatch:
+		s.writeEntry(w, r, key, "update")
+	case r.Method == http.MethodDelete:
+		latest, ok := s.latest(key)
+		if !ok {
+			utils.WriteCloudError(w, http.StatusNotFound, "NOT_FOUND", "Entry not found.")
+			return
+		}
+
+		latest.State = types.DataStoreEntryStateDeleted
+		s.entries[key] = append(s.entries[key], latest)
+		utils.WriteJSON(w, map[string]any{})
+	}
+}
+
+func (s *dataStoreServer) listDataStores(w http.ResponseWriter, r *http.Request) {
+	page := types.DataStoresResponse{DataStores: []types.DataStore{{Path: "data-stores/Coins", ID: "Coins"}}, NextPageToken: "page2"}
+	if r.URL.Query().Get("pageToken") == "page2" {
+		page = types.DataStoresResponse{DataStores: []types.DataStore{{Path: "data-stores/Players", ID: "Players"}}}
+	}
+
+	utils.WriteJSON(w, page)
+}
+
+func (s *dataStoreServer) listEntries(w http.ResponseWriter, r *http.Request, dataStore string) {
+	keys := make([]string, 0, len(s.entries))
+	for key := range s.entries {
+		if _, ok := s.latest(key); ok && strings.HasPrefix(key, dataStore+"/entries/") {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	// Serve one entry per page, with the index of the next entry as the page token
+	start, _ := strconv.Atoi(r.URL.Query().Get("pageToken"))
+	page := types.DataStoreEntriesResponse{DataStoreEntries: []types.DataStoreEntry{}}
+
+	if start < len(keys) {
+		id := strings.TrimPrefix(keys[start], dataStore+"/entries/")
+		page.DataStoreEntries = append(page.DataStoreEntries, types.DataStoreEntry{Path: keys[start], ID: id})
+
+		if start+1 < len(keys) {
+			page.NextPageToken = strconv.Itoa(start + 1)
+		}
+	}
+
+	utils.WriteJSON(w, page)
+}
+
+// writeEntry creates, updates or increments an entry, adding a new revision.
+func (s *dataStoreServer) writeEntry(w http.ResponseWriter, r *http.Request, key, action string) {
+	var body struct {
+		Value      json.RawMessage `json:"value"`
+		Amount     int64           `json:"amount"`
+		Users      []string        `json:"users"`
+		Attributes map[string]any  `json:"attributes"`
+		Etag       string          `json:"etag"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		utils.WriteCloudError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "Invalid body.")
+		return
+	}
+
+	latest, exists := s.latest(key)
+
+	switch {
+	case action == "create" && exists:
+		utils.WriteCloudError(w, http.StatusConflict, "ALREADY_EXISTS", "Entry already exists.")
+		return
+	case action == "update" && !exists && r.URL.Query().Get("allowMissing") != "true":
+		utils.WriteCloudError(w, http.StatusNotFound, "NOT_FOUND", "Entry not found.")
+		return
+	case body.Etag != "" && body.Etag != latest.Etag:
+		utils.WriteCloudError(w, http.StatusPreconditionFailed, "FAILED_PRECONDITION", "Etag mismatch.")
+		return
+	}
+
+	if action == "increment" {
+		var current int64
+		if exists {
+			_ = json.Unmarshal(latest.Value, &current)
+		}
+
+		body.Value = json.RawMessage(strconv.FormatInt(current+body.Amount, 10))
+	}
+
+	revision := len(s.entries[key]) + 1
+	entry := types.DataStoreEntry{
+		Path:               key,
+		ID:                 key[strings.LastIndex(key, "/")+1:],
+		CreateTime:         time.Unix(0, 0),
+		RevisionID:         fmt.Sprintf("rev-%d", revision),
+		RevisionCreateTime: time.Unix(int64(revision), 0),
+		State:              types.DataStoreEntryStateActive,
+		Etag:               fmt.Sprintf("etag-%d", revision),
+		Value:              body.Value,
+		Users:              body.Users,
+		Attributes:         body.Attributes,
+	}
+
+	s.entries[key] = append(s.entries[key], entry)
+	utils.WriteJSON(w, entry)
+}
+
+// latest returns the latest revision of an entry, if it exists and is not deleted.
+func (s *dataStoreServer) latest(key string) (types.DataStoreEntry, bool) {
+	revisions := s.entries[key]
+	if len(revisions) == 0 || revisions[len(revisions)-1].State == types.DataStoreEntryStateDeleted {
+		return types.DataStoreEntry{}, false
+	}
+
+	return revisions[len(revisions)-1], true
+}
+
+// playerData is a typed entry value.
+type playerData struct {
+	Level int      `json:"level"`
+	Items []string `json:"items"`
+}
+
+func TestDataStores(t *testing.T) {
+	server := newDataStoreServer(t)
+	defer server.Close()
+
+	api := datastores.New(utils.NewLocalTestEnv(server.URL))
+	ctx := context.Background()
+	entry := datastores.NewEntryBuilder(universeID, "Players", "player_1").Build()
+
+	t.Run("List Data Stores", func(t *testing.T) {
+		ids := make([]string, 0, 2)
+
+		for dataStore, err := range api.ListDataStoresAll(ctx, datastores.NewDataStoresBuilder(universeID).WithMaxPageSize(1).Build()) {
+			require.NoError(t, err)
+
+			ids = append(ids, dataStore.ID)
+		}
+
+		assert.Equal(t, []string{"Coins", "Players"}, ids)
+	})
+
+	t.Run("Create And Get Entry", func(t *testing.T) {
+		params := datastores.NewCreateEntryBuilder(entry, json.RawMessage(`{"level":1,"items":["sword"]}`)).
+			WithUserIDs(utils.SampleUserID1).
+			WithAttributes(map[string]any{"source": "backend"}).
+			Build()
+
+		created, err := api.CreateEntry(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, "player_1", created.ID)
+
+		value, fetched, err := datastores.Get[playerData](ctx, api, entry)
+		require.NoError(t, err)
+		assert.Equal(t, playerData{Level: 1, Items: []string{"sword"}}, value)
+		assert.Equal(t, []int64{utils.SampleUserID1}, fetched.UserIDs())
+		assert.Equal(t, "backend", fetched.Attributes["source"])
+		assert.Equal(t, types.DataStoreEntryStateActive, fetched.State)
+
+		_, err = api.CreateEntry(ctx, params)
+
+		var cloudErr *errs.CloudError
+		require.ErrorAs(t, err, &cloudErr)
+		assert.Equal(t, errs.CloudErrorAlreadyExists, cloudErr.Code)
+	})
+
+	t.Run("Update With Etag", func(t *testing.T) {
+		value, fetched, err := datastores.Get[playerData](ctx, api, entry)
+		require.NoError(t, err)
+
+		value.Level++
+		updated, err := datastores.Set(ctx, api, datastores.NewUpdateEntryBuilder(entry, nil).WithEtag(fetched.Etag).Build(), value)
+		require.NoError(t, err)
+		assert.NotEqual(t, fetched.Etag, updated.Etag)
+
+		// The etag read before the first update is now stale
+		_, err = datastores.Set(ctx, api, datastores.NewUpdateEntryBuilder(entry, nil).WithEtag(fetched.Etag).Build(), value)
+		require.ErrorIs(t, err, datastores.ErrEtagMismatch)
+
+		value, _, err = datastores.Get[playerData](ctx, api, entry)
+		require.NoError(t, err)
+		assert.Equal(t, 2, value.Level)
+	})
+
+	t.Run("Update Missing Entry", func(t *testing.T) {
+		missing := datastores.NewEntryBuilder(universeID, "Players", "player_2").Build()
+
+		_, err := datastores.Set(ctx, api, datastores.NewUpdateEntryBuilder(missing, nil).Build(), playerData{Level: 5})
+
+		var cloudErr *errs.CloudError
+		require.ErrorAs(t, err, &cloudErr)
+		assert.Equal(t, errs.CloudErrorNotFound, cloudErr.Code)
+		require.NotErrorIs(t, err, datastores.ErrEtagMismatch)
+
+		_, err = datastores.Set(ctx, api, datastores.NewUpdateEntryBuilder(missing, nil).WithAllowMissing(true).Build(), playerData{Level: 5})
+		require.NoError(t, err)
+	})
+
+	t.Run("List Entries", func(t *testing.T) {
+		ids := make([]string, 0, 2)
+
+		for listed, err := range api.ListEntriesAll(ctx, datastores.NewEntriesBuilder(universeID, "Players").Build()) {
+			require.NoError(t, err)
+
+			ids = append(ids, listed.ID)
+		}
+
+		assert.Equal(t, []string{"player_1", "player_2"}, ids)
+	})
+
+	t.Run("Increment Scoped Entry", func(t *testing.T) {
+		coins := datastores.NewEntryBuilder(universeID, "Coins", "player_1").WithScope("season_1").Build()
+
+		_, err := api.IncrementEntry(ctx, datastores.NewIncrementEntryBuilder(coins, 10).Build())
+		require.NoError(t, err)
+
+		incremented, err := api.IncrementEntry(ctx, datastores.NewIncrementEntryBuilder(coins, -3).Build())
+		require.NoError(t, err)
+		assert.JSONEq(t, "7", string(incremented.Value))
+
+		// The default scope is separate
+		_, err = api.GetEntry(ctx, datastores.NewEntryBuilder(universeID, "Coins", "player_1").Build())
+		require.Error(t, err)
+	})
+
+	t.Run("List And Get Revisions", func(t *testing.T) {
+		revisions := make([]*types.DataStoreEntry, 0, 2)
+
+		for revision, err := range api.ListRevisionsAll(ctx, datastores.NewRevisionsBuilder(entry).Build()) {
+			require.NoError(t, err)
+
+			revisions = append(revisions, revision)
+		}
+
+		require.Len(t, revisions, 2)
+		assert.Equal(t, "rev-2", revisions[0].RevisionID)
+
+		oldest, err := api.GetRevision(ctx, datastores.NewRevisionBuilder(entry, revisions[1].RevisionID).Build())
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"level":1,"items":["sword"]}`, string(oldest.Value))
+	})
+
+	t.Run("Delete Entry", func(t *testing.T) {
+		require.NoError(t, api.DeleteEntry(ctx, entry))
+
+		_, err := api.GetEntry(ctx, entry)
+
+		var cloudErr *errs.CloudError
+		require.ErrorAs(t, err, &cloudErr)
+		assert.Equal(t, errs.CloudErrorNotFound, cloudErr.Code)
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		_, err := api.GetEntry(ctx, datastores.NewEntryBuilder(0, "Players", "player_1").Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "UniverseID")
+
+		_, err = api.UpdateEntry(ctx, datastores.NewUpdateEntryBuilder(entry, json.RawMessage(`{invalid`)).Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+
+		_, err = api.CreateEntry(ctx, datastores.NewCreateEntryBuilder(entry, json.RawMessage(`1`)).WithUserIDs(1, 2, 3, 4, 5).Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "UserIDs")
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		params := datastores.NewEntriesBuilder(universeID, "Players").
+			WithMaxPageSize(50).
+			WithPageToken("token").
+			WithScope("scope").
+			WithFilter(`id.startsWith("player")`).
+			WithShowDeleted(true).
+			Build()
+
+		assert.Equal(t, int64(50), params.MaxPageSize)
+		assert.Equal(t, "token", params.PageToken)
+		assert.Equal(t, "scope", params.Scope)
+		assert.Equal(t, `id.startsWith("player")`, params.Filter)
+		assert.True(t, params.ShowDeleted)
+	})
+}
@@ -0,0 +1,29 @@
+package datastores
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+)
+
+// DeleteEntry marks a data store entry as deleted. Its revisions are kept for 30 days.
+// DELETE https://apis.roblox.com/cloud/v2/universes/{universe_id}/data-stores/{data_store_id}/entries/{entry_id}
+func (r *Resource) DeleteEntry(ctx context.Context, p EntryParams) error {
+	if err := r.validate.Struct(p); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodDelete).
+		URL(entryURL(p)).
+		Do(ctx)
+	if err != nil {
+		return errs.HandleCloudError(resp, err)
+	}
+
+	_ = resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package datastores
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// entryBody is the body of requests that write an entry.
+type entryBody struct {
+	Value      json.RawMessage `json:"value,omitempty"`
+	Users      []string        `json:"users,omitempty"`
+	Attributes map[string]any  `json:"attributes,omitempty"`
+	Etag       string          `json:"etag,omitempty"`
+}
+
+// dataStoreURL returns the URL of a data store, within a scope if one is given.
+func dataStoreURL(universeID int64, dataStoreID, scope string) string {
+	base := fmt.Sprintf("%s/universes/%d/data-stores/%s", types.OpenCloudEndpoint, universeID, url.PathEscape(dataStoreID))
+	if scope != "" {
+		base += "/scopes/" + url.PathEscape(scope)
+	}
+
+	return base
+}
+
+// entryURL returns the URL of an entry.
+func entryURL(p EntryParams) string {
+	return dataStoreURL(p.UniverseID, p.DataStoreID, p.Scope) + "/entries/" + url.PathEscape(p.EntryID)
+}
+
+// userPaths converts user IDs to the user paths the API expects.
+func userPaths(userIDs []int64) []string {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	paths := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		paths[i] = "users/" + strconv.FormatInt(id, 10)
+	}
+
+	return paths
+}
+
+// EntryParams identifies a data store entry.
+type EntryParams struct {
+	UniverseID  int64  `json:"universeId"  validate:"required,gt=0"`    // ID of the experience's universe
+	DataStoreID string `json:"dataStoreId" validate:"required,max=50"`  // Name of the data store
+	Scope       string `json:"scope"       validate:"omitempty,max=50"` // Scope of the entry, or empty for the default scope
+	EntryID     string `json:"entryId"     validate:"required,max=50"`  // Key of the entry
+}
+
+// EntryBuilder is a builder for EntryParams.
+type EntryBuilder struct {
+	params EntryParams
+}
+
+// NewEntryBuilder creates a new EntryBuilder with default values.
+func NewEntryBuilder(universeID int64, dataStoreID, entryID string) *EntryBuilder {
+	return &EntryBuilder{
+		params: EntryParams{
+			UniverseID:  universeID,
+			DataStoreID: dataStoreID,
+			Scope:       "",
+			EntryID:     entryID,
+		},
+	}
+}
+
+// WithScope sets the scope of the entry.
+func (b *EntryBuilder) WithScope(scope string) *EntryBuilder {
+	b.params.Scope = scope
+	return b
+}
+
+// Build returns the EntryParams.
+func (b *EntryBuilder) Build() EntryParams {
+	return b.params
+}
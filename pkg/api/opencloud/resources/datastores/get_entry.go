@@ -0,0 +1,60 @@
+package datastores
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetEntry fetches the latest revision of a data store entry.
+// GET https://apis.roblox.com/cloud/v2/universes/{universe_id}/data-stores/{data_store_id}/entries/{entry_id}
+func (r *Resource) GetEntry(ctx context.Context, p EntryParams) (*types.DataStoreEntry, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	return r.getEntry(ctx, entryURL(p))
+}
+
+// getEntry fetches the entry or revision at the URL.
+func (r *Resource) getEntry(ctx context.Context, url string) (*types.DataStoreEntry, error) {
+	var entry types.DataStoreEntry
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(url).
+		Result(&entry).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&entry); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &entry, nil
+}
+
+// Get fetches a data store entry and decodes its JSON value into T.
+// The entry is returned as well, so that its etag can be passed to Set.
+func Get[T any](ctx context.Context, r ResourceInterface, p EntryParams) (T, *types.DataStoreEntry, error) {
+	var value T
+
+	entry, err := r.GetEntry(ctx, p)
+	if err != nil {
+		return value, nil, err
+	}
+
+	if err := json.Unmarshal(entry.Value, &value); err != nil {
+		return value, entry, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return value, entry, nil
+}
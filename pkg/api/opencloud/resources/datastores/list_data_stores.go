@@ -0,0 +1,119 @@
+package datastores
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/pagination"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ListDataStores fetches a page of the data stores of an experience.
+// GET https://apis.roblox.com/cloud/v2/universes/{universe_id}/data-stores
+func (r *Resource) ListDataStores(ctx context.Context, p DataStoresParams) (*types.DataStoresResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var dataStores types.DataStoresResponse
+
+	req := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/universes/%d/data-stores", types.OpenCloudEndpoint, p.UniverseID))
+	if p.Filter != "" {
+		req = req.Query("filter", p.Filter)
+	}
+
+	if p.ShowDeleted {
+		req = req.Query("showDeleted", "true")
+	}
+
+	resp, err := pagination.Query(req, p.Params).
+		Result(&dataStores).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&dataStores); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &dataStores, nil
+}
+
+// ListDataStoresAll returns an iterator over every data store of an experience,
+// starting at the page token in the parameters and following pages until the last one.
+// Iteration stops at the first error, which is yielded with a nil data store.
+func (r *Resource) ListDataStoresAll(ctx context.Context, p DataStoresParams) iter.Seq2[*types.DataStore, error] {
+	return pagination.All(ctx, p.Params, func(ctx context.Context, page pagination.Params) ([]types.DataStore, string, error) {
+		p.Params = page
+
+		result, err := r.ListDataStores(ctx, p)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return result.DataStores, result.NextPageToken, nil
+	})
+}
+
+// DataStoresParams holds the parameters for listing data stores.
+type DataStoresParams struct {
+	pagination.Params
+
+	UniverseID  int64  `json:"universeId"  validate:"required,gt=0"` // ID of the experience's universe
+	Filter      string `json:"filter"`                               // Filter expression (e.g., `id.startsWith("player")`)
+	ShowDeleted bool   `json:"showDeleted"`                          // Whether to include deleted data stores
+}
+
+// DataStoresBuilder is a builder for DataStoresParams.
+type DataStoresBuilder struct {
+	params DataStoresParams
+}
+
+// NewDataStoresBuilder creates a new DataStoresBuilder with default values.
+func NewDataStoresBuilder(universeID int64) *DataStoresBuilder {
+	return &DataStoresBuilder{
+		params: DataStoresParams{
+			Params:      pagination.Params{MaxPageSize: 10, PageToken: ""},
+			UniverseID:  universeID,
+			Filter:      "",
+			ShowDeleted: false,
+		},
+	}
+}
+
+// WithMaxPageSize sets the maximum number of data stores per page.
+func (b *DataStoresBuilder) WithMaxPageSize(maxPageSize int64) *DataStoresBuilder {
+	b.params.MaxPageSize = maxPageSize
+	return b
+}
+
+// WithPageToken sets the token of the page to fetch.
+func (b *DataStoresBuilder) WithPageToken(pageToken string) *DataStoresBuilder {
+	b.params.PageToken = pageToken
+	return b
+}
+
+// WithFilter sets the filter expression.
+func (b *DataStoresBuilder) WithFilter(filter string) *DataStoresBuilder {
+	b.params.Filter = filter
+	return b
+}
+
+// WithShowDeleted sets whether to include deleted data stores.
+func (b *DataStoresBuilder) WithShowDeleted(showDeleted bool) *DataStoresBuilder {
+	b.params.ShowDeleted = showDeleted
+	return b
+}
+
+// Build returns the DataStoresParams.
+func (b *DataStoresBuilder) Build() DataStoresParams {
+	return b.params
+}
@@ -0,0 +1,130 @@
+package datastores
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/pagination"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ListEntries fetches a page of the entries of a data store.
+// Listed entries only have their path and ID set; fetch them with GetEntry for their values.
+// GET https://apis.roblox.com/cloud/v2/universes/{universe_id}/data-stores/{data_store_id}/entries
+func (r *Resource) ListEntries(ctx context.Context, p EntriesParams) (*types.DataStoreEntriesResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var entries types.DataStoreEntriesResponse
+
+	req := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(dataStoreURL(p.UniverseID, p.DataStoreID, p.Scope) + "/entries")
+	if p.Filter != "" {
+		req = req.Query("filter", p.Filter)
+	}
+
+	if p.ShowDeleted {
+		req = req.Query("showDeleted", "true")
+	}
+
+	resp, err := pagination.Query(req, p.Params).
+		Result(&entries).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&entries); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &entries, nil
+}
+
+// ListEntriesAll returns an iterator over every entry of a data store,
+// starting at the page token in the parameters and following pages until the last one.
+// Iteration stops at the first error, which is yielded with a nil entry.
+func (r *Resource) ListEntriesAll(ctx context.Context, p EntriesParams) iter.Seq2[*types.DataStoreEntry, error] {
+	return pagination.All(ctx, p.Params, func(ctx context.Context, page pagination.Params) ([]types.DataStoreEntry, string, error) {
+		p.Params = page
+
+		result, err := r.ListEntries(ctx, p)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return result.DataStoreEntries, result.NextPageToken, nil
+	})
+}
+
+// EntriesParams holds the parameters for listing data store entries.
+type EntriesParams struct {
+	pagination.Params
+
+	UniverseID  int64  `json:"universeId"  validate:"required,gt=0"`    // ID of the experience's universe
+	DataStoreID string `json:"dataStoreId" validate:"required,max=50"`  // Name of the data store
+	Scope       string `json:"scope"       validate:"omitempty,max=50"` // Scope to list, or empty for the default scope
+	Filter      string `json:"filter"`                                  // Filter expression (e.g., `id.startsWith("player")`)
+	ShowDeleted bool   `json:"showDeleted"`                             // Whether to include deleted entries
+}
+
+// EntriesBuilder is a builder for EntriesParams.
+type EntriesBuilder struct {
+	params EntriesParams
+}
+
+// NewEntriesBuilder creates a new EntriesBuilder with default values.
+func NewEntriesBuilder(universeID int64, dataStoreID string) *EntriesBuilder {
+	return &EntriesBuilder{
+		params: EntriesParams{
+			Params:      pagination.Params{MaxPageSize: 10, PageToken: ""},
+			UniverseID:  universeID,
+			DataStoreID: dataStoreID,
+			Scope:       "",
+			Filter:      "",
+			ShowDeleted: false,
+		},
+	}
+}
+
+// WithMaxPageSize sets the maximum number of entries per page.
+func (b *EntriesBuilder) WithMaxPageSize(maxPageSize int64) *EntriesBuilder {
+	b.params.MaxPageSize = maxPageSize
+	return b
+}
+
+// WithPageToken sets the token of the page to fetch.
+func (b *EntriesBuilder) WithPageToken(pageToken string) *EntriesBuilder {
+	b.params.PageToken = pageToken
+	return b
+}
+
+// WithScope sets the scope to list.
+func (b *EntriesBuilder) WithScope(scope string) *EntriesBuilder {
+	b.params.Scope = scope
+	return b
+}
+
+// WithFilter sets the filter expression.
+func (b *EntriesBuilder) WithFilter(filter string) *EntriesBuilder {
+	b.params.Filter = filter
+	return b
+}
+
+// WithShowDeleted sets whether to include deleted entries.
+func (b *EntriesBuilder) WithShowDeleted(showDeleted bool) *EntriesBuilder {
+	b.params.ShowDeleted = showDeleted
+	return b
+}
+
+// Build returns the EntriesParams.
+func (b *EntriesBuilder) Build() EntriesParams {
+	return b.params
+}
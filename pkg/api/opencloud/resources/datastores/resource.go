@@ -0,0 +1,43 @@
+package datastores
+
+import (
+	"context"
+	"iter"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ResourceInterface defines the interface for data store-related operations.
+type ResourceInterface interface {
+	ListDataStores(ctx context.Context, p DataStoresParams) (*types.DataStoresResponse, error)
+	ListDataStoresAll(ctx context.Context, p DataStoresParams) iter.Seq2[*types.DataStore, error]
+	ListEntries(ctx context.Context, p EntriesParams) (*types.DataStoreEntriesResponse, error)
+	ListEntriesAll(ctx context.Context, p EntriesParams) iter.Seq2[*types.DataStoreEntry, error]
+	GetEntry(ctx context.Context, p EntryParams) (*types.DataStoreEntry, error)
+	CreateEntry(ctx context.Context, p CreateEntryParams) (*types.DataStoreEntry, error)
+	UpdateEntry(ctx context.Context, p UpdateEntryParams) (*types.DataStoreEntry, error)
+	IncrementEntry(ctx context.Context, p IncrementEntryParams) (*types.DataStoreEntry, error)
+	DeleteEntry(ctx context.Context, p EntryParams) error
+	ListRevisions(ctx context.Context, p RevisionsParams) (*types.DataStoreEntriesResponse, error)
+	ListRevisionsAll(ctx context.Context, p RevisionsParams) iter.Seq2[*types.DataStoreEntry, error]
+	GetRevision(ctx context.Context, p RevisionParams) (*types.DataStoreEntry, error)
+}
+
+// Ensure Resource implements the ResourceInterface.
+var _ ResourceInterface = (*Resource)(nil)
+
+// Resource provides methods for interacting with the Open Cloud data store endpoints.
+type Resource struct {
+	client   *client.Client
+	validate *validator.Validate
+}
+
+// New creates a new Resource with the specified client and validator.
+func New(client *client.Client, validate *validator.Validate) *Resource {
+	return &Resource{
+		client:   client,
+		validate: validate,
+	}
+}
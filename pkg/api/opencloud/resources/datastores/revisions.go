@@ -0,0 +1,145 @@
+package datastores
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/pagination"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ListRevisions fetches a page of the revisions of a data store entry, newest first.
+// GET https://apis.roblox.com/cloud/v2/universes/{universe_id}/data-stores/{data_store_id}/entries/{entry_id}:listRevisions
+func (r *Resource) ListRevisions(ctx context.Context, p RevisionsParams) (*types.DataStoreEntriesResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var revisions types.DataStoreEntriesResponse
+
+	req := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(entryURL(p.EntryParams) + ":listRevisions")
+	if p.Filter != "" {
+		req = req.Query("filter", p.Filter)
+	}
+
+	resp, err := pagination.Query(req, p.Params).
+		Result(&revisions).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&revisions); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &revisions, nil
+}
+
+// ListRevisionsAll returns an iterator over every revision of a data store entry,
+// starting at the page token in the parameters and following pages until the last one.
+// Iteration stops at the first error, which is yielded with a nil revision.
+func (r *Resource) ListRevisionsAll(ctx context.Context, p RevisionsParams) iter.Seq2[*types.DataStoreEntry, error] {
+	return pagination.All(ctx, p.Params, func(ctx context.Context, page pagination.Params) ([]types.DataStoreEntry, string, error) {
+		p.Params = page
+
+		result, err := r.ListRevisions(ctx, p)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return result.DataStoreEntries, result.NextPageToken, nil
+	})
+}
+
+// GetRevision fetches a specific revision of a data store entry.
+// GET https://apis.roblox.com/cloud/v2/universes/{universe_id}/data-stores/{data_store_id}/entries/{entry_id}@{revision_id}
+func (r *Resource) GetRevision(ctx context.Context, p RevisionParams) (*types.DataStoreEntry, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	return r.getEntry(ctx, entryURL(p.EntryParams)+"@"+url.PathEscape(p.RevisionID))
+}
+
+// RevisionsParams holds the parameters for listing the revisions of a data store entry.
+type RevisionsParams struct {
+	pagination.Params
+	EntryParams
+
+	Filter string `json:"filter"` // Filter expression (e.g., `revision_create_time >= 2024-01-01T00:00:00Z`)
+}
+
+// RevisionsBuilder is a builder for RevisionsParams.
+type RevisionsBuilder struct {
+	params RevisionsParams
+}
+
+// NewRevisionsBuilder creates a new RevisionsBuilder with default values.
+func NewRevisionsBuilder(entry EntryParams) *RevisionsBuilder {
+	return &RevisionsBuilder{
+		params: RevisionsParams{
+			Params:      pagination.Params{MaxPageSize: 10, PageToken: ""},
+			EntryParams: entry,
+			Filter:      "",
+		},
+	}
+}
+
+// WithMaxPageSize sets the maximum number of revisions per page.
+func (b *RevisionsBuilder) WithMaxPageSize(maxPageSize int64) *RevisionsBuilder {
+	b.params.MaxPageSize = maxPageSize
+	return b
+}
+
+// WithPageToken sets the token of the page to fetch.
+func (b *RevisionsBuilder) WithPageToken(pageToken string) *RevisionsBuilder {
+	b.params.PageToken = pageToken
+	return b
+}
+
+// WithFilter sets the filter expression.
+func (b *RevisionsBuilder) WithFilter(filter string) *RevisionsBuilder {
+	b.params.Filter = filter
+	return b
+}
+
+// Build returns the RevisionsParams.
+func (b *RevisionsBuilder) Build() RevisionsParams {
+	return b.params
+}
+
+// RevisionParams identifies a revision of a data store entry.
+type RevisionParams struct {
+	EntryParams
+
+	RevisionID string `json:"revisionId" validate:"required"` // ID of the revision, "latest", or an RFC 3339 time to get the revision current at that time
+}
+
+// RevisionBuilder is a builder for RevisionParams.
+type RevisionBuilder struct {
+	params RevisionParams
+}
+
+// NewRevisionBuilder creates a new RevisionBuilder with default values.
+func NewRevisionBuilder(entry EntryParams, revisionID string) *RevisionBuilder {
+	return &RevisionBuilder{
+		params: RevisionParams{
+			EntryParams: entry,
+			RevisionID:  revisionID,
+		},
+	}
+}
+
+// Build returns the RevisionParams.
+func (b *RevisionBuilder) Build() RevisionParams {
+	return b.params
+}
@@ -0,0 +1,144 @@
+package datastores
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ErrEtagMismatch is returned when an entry was changed since the etag it was updated with was read.
+var ErrEtagMismatch = errors.New("data store entry was modified concurrently")
+
+// UpdateEntry replaces the value, users and attributes of a data store entry.
+// If an etag is set, the update only succeeds if the entry has not changed since that
+// revision; otherwise the error wraps ErrEtagMismatch.
+// PATCH https://apis.roblox.com/cloud/v2/universes/{universe_id}/data-stores/{data_store_id}/entries/{entry_id}
+func (r *Resource) UpdateEntry(ctx context.Context, p UpdateEntryParams) (*types.DataStoreEntry, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	if !json.Valid(p.Value) {
+		return nil, fmt.Errorf("%w: value is not valid JSON", errs.ErrInvalidRequest)
+	}
+
+	var entry types.DataStoreEntry
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPatch).
+		URL(entryURL(p.EntryParams)).
+		Query("allowMissing", strconv.FormatBool(p.AllowMissing)).
+		MarshalBody(entryBody{
+			Value:      p.Value,
+			Users:      userPaths(p.UserIDs),
+			Attributes: p.Attributes,
+			Etag:       p.Etag,
+		}).
+		Result(&entry).
+		Do(ctx)
+	if err != nil {
+		return nil, etagError(p.Etag, errs.HandleCloudError(resp, err))
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&entry); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &entry, nil
+}
+
+// Set JSON-encodes the value and writes it to a data store entry with UpdateEntry,
+// using the other parameters as they are. The value in the parameters is ignored.
+func Set[T any](ctx context.Context, r ResourceInterface, p UpdateEntryParams, value T) (*types.DataStoreEntry, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	p.Value = encoded
+
+	return r.UpdateEntry(ctx, p)
+}
+
+// etagError marks a rejected conditional update as an etag mismatch.
+func etagError(etag string, err error) error {
+	var cloudErr *errs.CloudError
+	if etag == "" || !errors.As(err, &cloudErr) {
+		return err
+	}
+
+	switch cloudErr.Code { //nolint:exhaustive // Only conflicts are etag mismatches
+	case errs.CloudErrorAborted, errs.CloudErrorFailedPrecondition:
+		return fmt.Errorf("%w: %w", ErrEtagMismatch, err)
+	default:
+		return err
+	}
+}
+
+// UpdateEntryParams holds the parameters for updating a data store entry.
+type UpdateEntryParams struct {
+	EntryParams
+
+	Value        json.RawMessage `json:"value"        validate:"required"`        // JSON value of the entry
+	UserIDs      []int64         `json:"userIds"      validate:"max=4,dive,gt=0"` // IDs of the users associated with the entry
+	Attributes   map[string]any  `json:"attributes"`                              // Custom metadata of the entry
+	Etag         string          `json:"etag"`                                    // Etag of the revision the update is based on, or empty to overwrite unconditionally
+	AllowMissing bool            `json:"allowMissing"`                            // Whether to create the entry if it does not exist
+}
+
+// UpdateEntryBuilder is a builder for UpdateEntryParams.
+type UpdateEntryBuilder struct {
+	params UpdateEntryParams
+}
+
+// NewUpdateEntryBuilder creates a new UpdateEntryBuilder with default values.
+// The value can be left empty when the parameters are passed to Set.
+func NewUpdateEntryBuilder(entry EntryParams, value json.RawMessage) *UpdateEntryBuilder {
+	return &UpdateEntryBuilder{
+		params: UpdateEntryParams{
+			EntryParams:  entry,
+			Value:        value,
+			UserIDs:      nil,
+			Attributes:   nil,
+			Etag:         "",
+			AllowMissing: false,
+		},
+	}
+}
+
+// WithUserIDs sets the IDs of the users associated with the entry.
+func (b *UpdateEntryBuilder) WithUserIDs(userIDs ...int64) *UpdateEntryBuilder {
+	b.params.UserIDs = userIDs
+	return b
+}
+
+// WithAttributes sets the custom metadata of the entry.
+func (b *UpdateEntryBuilder) WithAttributes(attributes map[string]any) *UpdateEntryBuilder {
+	b.params.Attributes = attributes
+	return b
+}
+
+// WithEtag makes the update conditional on the entry still being at the revision of the etag.
+func (b *UpdateEntryBuilder) WithEtag(etag string) *UpdateEntryBuilder {
+	b.params.Etag = etag
+	return b
+}
+
+// WithAllowMissing sets whether to create the entry if it does not exist.
+func (b *UpdateEntryBuilder) WithAllowMissing(allowMissing bool) *UpdateEntryBuilder {
+	b.params.AllowMissing = allowMissing
+	return b
+}
+
+// Build returns the UpdateEntryParams.
+func (b *UpdateEntryBuilder) Build() UpdateEntryParams {
+	return b.params
+}
@@ -0,0 +1,205 @@
+package memorystore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ErrHandlerFailed is reported when a handler fails to process a queue item.
+var ErrHandlerFailed = errors.New("queue item handler failed")
+
+// Handler processes a single queue item. It should be idempotent, as an item is
+// processed again if it or another item of the same read fails.
+type Handler func(ctx context.Context, item *types.QueueItem) error
+
+// Consumer reads items from a queue and processes them with a handler.
+//
+// Each worker repeatedly reads up to BatchSize items, processes them in order and
+// discards them once all of them succeeded. If any item fails, the read is not
+// discarded and its items return to the queue when the invisibility window ends, so
+// the window must be longer than processing a batch takes. Workers wait PollInterval
+// when the queue is empty or a request fails.
+type Consumer struct {
+	resource ResourceInterface
+	params   ConsumerParams
+	handler  Handler
+	errors   chan error
+}
+
+// NewConsumer creates a new Consumer that reads through the given resource.
+func NewConsumer(resource ResourceInterface, validate *validator.Validate, p ConsumerParams, handler Handler) (*Consumer, error) {
+	if err := validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	return &Consumer{
+		resource: resource,
+		params:   p,
+		handler:  handler,
+		errors:   make(chan error, p.BufferSize),
+	}, nil
+}
+
+// Errors returns the channel failed requests and handlers are reported on.
+// Errors are dropped when the channel is full, and it is closed when Run returns.
+func (c *Consumer) Errors() <-chan error {
+	return c.errors
+}
+
+// Run starts the workers and blocks until the context is done and every worker has
+// stopped. It must only be called once.
+func (c *Consumer) Run(ctx context.Context) error {
+	defer close(c.errors)
+
+	var wg sync.WaitGroup
+
+	for range c.params.Workers {
+		wg.Go(func() { c.work(ctx) })
+	}
+
+	wg.Wait()
+
+	return ctx.Err()
+}
+
+// work reads and processes batches until the context is done.
+func (c *Consumer) work(ctx context.Context) {
+	for ctx.Err() == nil {
+		if !c.consume(ctx) {
+			select {
+			case <-ctx.Done():
+			case <-time.After(c.params.PollInterval):
+			}
+		}
+	}
+}
+
+// consume reads and processes a single batch.
+// It reports whether any items were read, so that workers only wait on an empty queue.
+func (c *Consumer) consume(ctx context.Context) bool {
+	result, err := c.resource.ReadQueue(ctx, ReadQueueParams{
+		UniverseID:         c.params.UniverseID,
+		QueueID:            c.params.QueueID,
+		Count:              c.params.BatchSize,
+		AllOrNothing:       false,
+		InvisibilityWindow: c.params.InvisibilityWindow,
+	})
+	if err != nil {
+		c.report(err)
+		return false
+	}
+
+	if len(result.Items) == 0 {
+		return false
+	}
+
+	failed := false
+
+	for i := range result.Items {
+		if err := c.handler(ctx, &result.Items[i]); err != nil {
+			c.report(fmt.Errorf("%w: %w", ErrHandlerFailed, err))
+			failed = true
+		}
+	}
+
+	// Leave the items to reappear once the invisibility window ends
+	if failed || ctx.Err() != nil {
+		return true
+	}
+
+	err = c.resource.DiscardQueueItems(ctx, DiscardQueueItemsParams{
+		UniverseID: c.params.UniverseID,
+		QueueID:    c.params.QueueID,
+		ReadID:     result.ReadID,
+	})
+	if err != nil {
+		c.report(err)
+	}
+
+	return true
+}
+
+// report sends an error without blocking, dropping it if the channel is full.
+func (c *Consumer) report(err error) {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+
+	select {
+	case c.errors <- err:
+	default:
+	}
+}
+
+// ConsumerParams holds the parameters for consuming a queue.
+type ConsumerParams struct {
+	UniverseID         int64         `json:"universeId"         validate:"required,gt=0"`    // ID of the experience's universe
+	QueueID            string        `json:"queueId"            validate:"required,max=128"` // Name of the queue
+	Workers            int           `json:"workers"            validate:"min=1,max=100"`    // Number of batches processed at once
+	BatchSize          int64         `json:"batchSize"          validate:"min=1,max=200"`    // Maximum number of items per read
+	InvisibilityWindow time.Duration `json:"invisibilityWindow" validate:"gte=1s"`           // How long read items are hidden from other readers
+	PollInterval       time.Duration `json:"pollInterval"       validate:"gt=0"`             // How long workers wait when the queue is empty or a request fails
+	BufferSize         int           `json:"bufferSize"         validate:"min=0"`            // Capacity of the error channel
+}
+
+// ConsumerBuilder is a builder for ConsumerParams.
+type ConsumerBuilder struct {
+	params ConsumerParams
+}
+
+// NewConsumerBuilder creates a new ConsumerBuilder with default values.
+func NewConsumerBuilder(universeID int64, queueID string) *ConsumerBuilder {
+	return &ConsumerBuilder{
+		params: ConsumerParams{
+			UniverseID:         universeID,
+			QueueID:            queueID,
+			Workers:            4,
+			BatchSize:          1,
+			InvisibilityWindow: 30 * time.Second,
+			PollInterval:       time.Second,
+			BufferSize:         100,
+		},
+	}
+}
+
+// WithWorkers sets the number of batches processed at once.
+func (b *ConsumerBuilder) WithWorkers(workers int) *ConsumerBuilder {
+	b.params.Workers = workers
+	return b
+}
+
+// WithBatchSize sets the maximum number of items per read.
+func (b *ConsumerBuilder) WithBatchSize(batchSize int64) *ConsumerBuilder {
+	b.params.BatchSize = batchSize
+	return b
+}
+
+// WithInvisibilityWindow sets how long read items are hidden from other readers.
+func (b *ConsumerBuilder) WithInvisibilityWindow(invisibilityWindow time.Duration) *ConsumerBuilder {
+	b.params.InvisibilityWindow = invisibilityWindow
+	return b
+}
+
+// WithPollInterval sets how long workers wait when the queue is empty or a request fails.
+func (b *ConsumerBuilder) WithPollInterval(pollInterval time.Duration) *ConsumerBuilder {
+	b.params.PollInterval = pollInterval
+	return b
+}
+
+// WithBufferSize sets the capacity of the error channel.
+func (b *ConsumerBuilder) WithBufferSize(bufferSize int) *ConsumerBuilder {
+	b.params.BufferSize = bufferSize
+	return b
+}
+
+// Build returns the ConsumerParams.
+func (b *ConsumerBuilder) Build() ConsumerParams {
+	return b.params
+}
@@ -0,0 +1,57 @@
+package memorystore
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetSortedMapItem fetches an item of a sorted map.
+// GET https://apis.roblox.com/cloud/v2/universes/{universe_id}/memory-store/sorted-maps/{sorted_map_id}/items/{item_id}
+func (r *Resource) GetSortedMapItem(ctx context.Context, p SortedMapItemParams) (*types.SortedMapItem, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var item types.SortedMapItem
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(sortedMapItemURL(p)).
+		Result(&item).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&item); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &item, nil
+}
+
+// DeleteSortedMapItem removes an item from a sorted map.
+// DELETE https://apis.roblox.com/cloud/v2/universes/{universe_id}/memory-store/sorted-maps/{sorted_map_id}/items/{item_id}
+func (r *Resource) DeleteSortedMapItem(ctx context.Context, p SortedMapItemParams) error {
+	if err := r.validate.Struct(p); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodDelete).
+		URL(sortedMapItemURL(p)).
+		Do(ctx)
+	if err != nil {
+		return errs.HandleCloudError(resp, err)
+	}
+
+	_ = resp.Body.Close()
+
+	return nil
+}
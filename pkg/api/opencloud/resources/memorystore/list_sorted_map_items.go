@@ -0,0 +1,121 @@
+package memorystore
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/pagination"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ListSortedMapItems fetches a page of the items of a sorted map, in sort order.
+// GET https://apis.roblox.com/cloud/v2/universes/{universe_id}/memory-store/sorted-maps/{sorted_map_id}/items
+func (r *Resource) ListSortedMapItems(ctx context.Context, p SortedMapItemsParams) (*types.SortedMapItemsResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var items types.SortedMapItemsResponse
+
+	req := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(sortedMapURL(p.UniverseID, p.SortedMapID) + "/items")
+	if p.Descending {
+		req = req.Query("orderBy", "desc")
+	}
+
+	if p.Filter != "" {
+		req = req.Query("filter", p.Filter)
+	}
+
+	resp, err := pagination.Query(req, p.Params).
+		Result(&items).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&items); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &items, nil
+}
+
+// ListSortedMapItemsAll returns an iterator over every item of a sorted map,
+// starting at the page token in the parameters and following pages until the last one.
+// Iteration stops at the first error, which is yielded with a nil item.
+func (r *Resource) ListSortedMapItemsAll(ctx context.Context, p SortedMapItemsParams) iter.Seq2[*types.SortedMapItem, error] {
+	return pagination.All(ctx, p.Params, func(ctx context.Context, page pagination.Params) ([]types.SortedMapItem, string, error) {
+		p.Params = page
+
+		result, err := r.ListSortedMapItems(ctx, p)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return result.Items, result.NextPageToken, nil
+	})
+}
+
+// SortedMapItemsParams holds the parameters for listing sorted map items.
+type SortedMapItemsParams struct {
+	pagination.Params
+
+	UniverseID  int64  `json:"universeId"  validate:"required,gt=0"`    // ID of the experience's universe
+	SortedMapID string `json:"sortedMapId" validate:"required,max=128"` // Name of the sorted map
+	Descending  bool   `json:"descending"`                              // Whether to list in descending order
+	Filter      string `json:"filter"`                                  // Filter expression on IDs and sort keys (e.g., `id > "player_10"`)
+}
+
+// SortedMapItemsBuilder is a builder for SortedMapItemsParams.
+type SortedMapItemsBuilder struct {
+	params SortedMapItemsParams
+}
+
+// NewSortedMapItemsBuilder creates a new SortedMapItemsBuilder with default values.
+func NewSortedMapItemsBuilder(universeID int64, sortedMapID string) *SortedMapItemsBuilder {
+	return &SortedMapItemsBuilder{
+		params: SortedMapItemsParams{
+			Params:      pagination.Params{MaxPageSize: 10, PageToken: ""},
+			UniverseID:  universeID,
+			SortedMapID: sortedMapID,
+			Descending:  false,
+			Filter:      "",
+		},
+	}
+}
+
+// WithMaxPageSize sets the maximum number of items per page.
+func (b *SortedMapItemsBuilder) WithMaxPageSize(maxPageSize int64) *SortedMapItemsBuilder {
+	b.params.MaxPageSize = maxPageSize
+	return b
+}
+
+// WithPageToken sets the token of the page to fetch.
+func (b *SortedMapItemsBuilder) WithPageToken(pageToken string) *SortedMapItemsBuilder {
+	b.params.PageToken = pageToken
+	return b
+}
+
+// WithDescending sets whether to list in descending order.
+func (b *SortedMapItemsBuilder) WithDescending(descending bool) *SortedMapItemsBuilder {
+	b.params.Descending = descending
+	return b
+}
+
+// WithFilter sets the filter expression.
+func (b *SortedMapItemsBuilder) WithFilter(filter string) *SortedMapItemsBuilder {
+	b.params.Filter = filter
+	return b
+}
+
+// Build returns the SortedMapItemsParams.
+func (b *SortedMapItemsBuilder) Build() SortedMapItemsParams {
+	return b.params
+}
@@ -0,0 +1,512 @@
+package memorystore_test
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/resources/memorystore"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const universeID = int64(1)
+
+var errTemporary = errors.New("temporary failure")
+
+// queuedItem is an item of a queue on the memory store server.
+type queuedItem struct {
+	item      types.QueueItem
+	order     int
+	readID    string
+	invisible time.Time
+}
+
+// memoryStoreServer is an in-memory stand-in for the Open Cloud memory store endpoints.
+type memoryStoreServer struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	items  map[string]types.SortedMapItem // Sorted map items by path
+	queues map[string][]*queuedItem       // Queue items by queue path
+	reads  int
+}
+
+func newMemoryStoreServer(t *testing.T) *memoryStoreServer {
+	t.Helper()
+
+	s := &memoryStoreServer{
+		items:  make(map[string]types.SortedMapItem),
+		queues: make(map[string][]*queuedItem),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		path := strings.TrimPrefix(r.URL.Path, fmt.Sprintf("/cloud/v2/universes/%d/memory-store/", universeID))
+
+		switch {
+		case strings.HasPrefix(path, "sorted-maps/"):
+			s.serveSortedMap(w, r, path)
+		case strings.HasPrefix(path, "queues/"):
+			s.serveQueue(w, r, path)
+		default:
+			utils.WriteCloudError(w, http.StatusNotFound, "NOT_FOUND", "Unknown path.")
+		}
+	}))
+
+	return s
+}
+
+func (s *memoryStoreServer) serveSortedMap(w http.ResponseWriter, r *http.Request, path string) {
+	if strings.HasSuffix(path, "/items") {
+		if r.Method == http.MethodGet {
+			s.listSortedMapItems(w, r, path)
+			return
+		}
+
+		s.writeSortedMapItem(w, r, path+"/"+r.URL.Query().Get("id"), true)
+
+		return
+	}
+
+	item, exists := s.items[path]
+
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			utils.WriteCloudError(w, http.StatusNotFound, "NOT_FOUND", "Item not found.")
+			return
+		}
+
+		utils.WriteJSON(w, item)
+	case http.MethodPatch:
+		if !exists && r.URL.Query().Get("allowMissing") != "true" {
+			utils.WriteCloudError(w, http.StatusNotFound, "NOT_FOUND", "Item not found.")
+			return
+		}
+
+		s.writeSortedMapItem(w, r, path, false)
+	case http.MethodDelete:
+		delete(s.items, path)
+		utils.WriteJSON(w, map[string]any{})
+	}
+}
+
+func (s *memoryStoreServer) listSortedMapItems(w http.ResponseWriter, r *http.Request, path string) {
+	items := make([]types.SortedMapItem, 0, len(s.items))
+	for itemPath, item := range s.items {
+		if strings.HasPrefix(itemPath, path+"/") {
+			items = append(items, item)
+		}
+	}
+
+	// Order by sort key first and ID second, like the real sorted maps
+	slices.SortFunc(items, func(a, b types.SortedMapItem) int {
+		if a.NumericSortKey != nil && b.NumericSortKey != nil {
+			if c := cmp.Compare(*a.NumericSortKey, *b.NumericSortKey); c != 0 {
+				return c
+			}
+		}
+
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	if r.URL.Query().Get("orderBy") == "desc" {
+		slices.Reverse(items)
+	}
+
+	start, _ := strconv.Atoi(r.URL.Query().Get("pageToken"))
+	size, _ := strconv.Atoi(r.URL.Query().Get("maxPageSize"))
+	end := min(start+size, len(items))
+
+	page := types.SortedMapItemsResponse{Items: items[start:end]}
+	if end < len(items) {
+		page.NextPageToken = strconv.Itoa(end)
+	}
+
+	utils.WriteJSON(w, page)
+}
+
+func (s *memoryStoreServer) writeSortedMapItem(w http.ResponseWriter, r *http.Request, path string, create bool) {
+	var body struct {
+		Value          json.RawMessage `json:"value"`
+		TTL            string          `json:"ttl"`
+		Etag           string          `json:"etag"`
+		StringSortKey  *string         `json:"stringSortKey"`
+		NumericSortKey *float64        `json:"numericSortKey"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		utils.WriteCloudError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "Invalid body.")
+		return
+	}
+
+	ttl, err := time.ParseDuration(body.TTL)
+	if err != nil {
+		utils.WriteCloudError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "Invalid TTL.")
+		return
+	}
+
+	existing, exists := s.items[path]
+
+	switch {
+	case create && exists:
+		utils.WriteCloudError(w, http.StatusConflict, "ALREADY_EXISTS", "Item already exists.")
+		return
+	case body.Etag != "" && body.Etag != existing.Etag:
+		utils.WriteCloudError(w, http.StatusPreconditionFailed, "FAILED_PRECONDITION", "Etag mismatch.")
+		return
+	}
+
+	item := types.SortedMapItem{
+		Path:           path,
+		ID:             path[strings.LastIndex(path, "/")+1:],
+		Value:          body.Value,
+		Etag:           strconv.FormatInt(time.Now().UnixNano(), 10),
+		ExpireTime:     time.Now().Add(ttl),
+		StringSortKey:  body.StringSortKey,
+		NumericSortKey: body.NumericSortKey,
+	}
+
+	s.items[path] = item
+	utils.WriteJSON(w, item)
+}
+
+func (s *memoryStoreServer) serveQueue(w http.ResponseWriter, r *http.Request, path string) {
+	queue, action, _ := strings.Cut(path, "/items")
+
+	switch action {
+	case "":
+		var body struct {
+			Data     json.RawMessage `json:"data"`
+			Priority float64         `json:"priority"`
+			TTL      string          `json:"ttl"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			utils.WriteCloudError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "Invalid body.")
+			return
+		}
+
+		item := types.QueueItem{Path: queue + "/items", Data: body.Data, Priority: body.Priority}
+		s.queues[queue] = append(s.queues[queue], &queuedItem{item: item, order: len(s.queues[queue])})
+		utils.WriteJSON(w, item)
+	case ":read":
+		s.readQueue(w, r, queue)
+	case ":discard":
+		var body struct {
+			ReadID string `json:"readId"`
+		}
+
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		s.queues[queue] = slices.DeleteFunc(s.queues[queue], func(item *queuedItem) bool {
+			return item.readID == body.ReadID
+		})
+
+		utils.WriteJSON(w, map[string]any{})
+	}
+}
+
+func (s *memoryStoreServer) readQueue(w http.ResponseWriter, r *http.Request, queue string) {
+	count, _ := strconv.Atoi(r.URL.Query().Get("count"))
+
+	window, err := time.ParseDuration(r.URL.Query().Get("invisibilityWindow"))
+	if err != nil {
+		utils.WriteCloudError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "Invalid invisibility window.")
+		return
+	}
+
+	visible := slices.DeleteFunc(slices.Clone(s.queues[queue]), func(item *queuedItem) bool {
+		return time.Now().Before(item.invisible)
+	})
+	slices.SortFunc(visible, func(a, b *queuedItem) int {
+		return cmp.Or(cmp.Compare(b.item.Priority, a.item.Priority), cmp.Compare(a.order, b.order))
+	})
+
+	s.reads++
+	result := types.QueueReadResponse{ReadID: fmt.Sprintf("read-%d", s.reads), Data: []json.RawMessage{}, Items: []types.QueueItem{}}
+
+	for _, item := range visible[:min(count, len(visible))] {
+		item.readID = result.ReadID
+		item.invisible = time.Now().Add(window)
+
+		result.Data = append(result.Data, item.item.Data)
+		result.Items = append(result.Items, item.item)
+	}
+
+	// Stands in for a malformed response
+	if queue == "queues/NoReadID" {
+		result.ReadID = ""
+	}
+
+	utils.WriteJSON(w, result)
+}
+
+// queueLength returns the number of items left in a queue, visible or not.
+func (s *memoryStoreServer) queueLength(queue string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.queues["queues/"+queue])
+}
+
+func TestSortedMaps(t *testing.T) {
+	server := newMemoryStoreServer(t)
+	defer server.Close()
+
+	api := memorystore.New(utils.NewLocalTestEnv(server.URL))
+	ctx := context.Background()
+
+	t.Run("Create And List In Sort Order", func(t *testing.T) {
+		scores := map[string]float64{"player_a": 30, "player_b": 10, "player_c": 20}
+
+		for id, score := range scores {
+			item := memorystore.NewSortedMapItemBuilder(universeID, "Leaderboard", id).Build()
+			params := memorystore.NewWriteSortedMapItemBuilder(item, json.RawMessage(`{"name":"`+id+`"}`), time.Hour).
+				WithNumericSortKey(score).
+				Build()
+
+			created, err := api.CreateSortedMapItem(ctx, params)
+			require.NoError(t, err)
+			assert.Equal(t, id, created.ID)
+			assert.InDelta(t, score, *created.NumericSortKey, 0)
+		}
+
+		ids := make([]string, 0, len(scores))
+		params := memorystore.NewSortedMapItemsBuilder(universeID, "Leaderboard").WithMaxPageSize(2).WithDescending(true).Build()
+
+		for item, err := range api.ListSortedMapItemsAll(ctx, params) {
+			require.NoError(t, err)
+
+			ids = append(ids, item.ID)
+		}
+
+		assert.Equal(t, []string{"player_a", "player_c", "player_b"}, ids)
+	})
+
+	t.Run("Update With Etag", func(t *testing.T) {
+		item := memorystore.NewSortedMapItemBuilder(universeID, "Leaderboard", "player_b").Build()
+
+		fetched, err := api.GetSortedMapItem(ctx, item)
+		require.NoError(t, err)
+
+		updated, err := api.UpdateSortedMapItem(ctx, memorystore.NewWriteSortedMapItemBuilder(item, json.RawMessage(`{"name":"b"}`), time.Minute).
+			WithNumericSortKey(40).
+			WithEtag(fetched.Etag).
+			Build())
+		require.NoError(t, err)
+		assert.InDelta(t, 40, *updated.NumericSortKey, 0)
+
+		_, err = api.UpdateSortedMapItem(ctx, memorystore.NewWriteSortedMapItemBuilder(item, json.RawMessage(`{}`), time.Minute).
+			WithEtag(fetched.Etag).
+			Build())
+
+		var cloudErr *errs.CloudError
+		require.ErrorAs(t, err, &cloudErr)
+		assert.Equal(t, errs.CloudErrorFailedPrecondition, cloudErr.Code)
+	})
+
+	t.Run("Delete Item", func(t *testing.T) {
+		item := memorystore.NewSortedMapItemBuilder(universeID, "Leaderboard", "player_c").Build()
+		require.NoError(t, api.DeleteSortedMapItem(ctx, item))
+
+		_, err := api.GetSortedMapItem(ctx, item)
+
+		var cloudErr *errs.CloudError
+		require.ErrorAs(t, err, &cloudErr)
+		assert.Equal(t, errs.CloudErrorNotFound, cloudErr.Code)
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		item := memorystore.NewSortedMapItemBuilder(universeID, "Leaderboard", "player_d").Build()
+
+		_, err := api.CreateSortedMapItem(ctx, memorystore.NewWriteSortedMapItemBuilder(item, json.RawMessage(`1`), 46*24*time.Hour).Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "TTL")
+
+		params := memorystore.NewWriteSortedMapItemBuilder(item, json.RawMessage(`1`), time.Hour).Build()
+		key := "key"
+		score := 1.0
+		params.StringSortKey, params.NumericSortKey = &key, &score
+
+		_, err = api.CreateSortedMapItem(ctx, params)
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "NumericSortKey")
+	})
+}
+
+func TestQueues(t *testing.T) {
+	server := newMemoryStoreServer(t)
+	defer server.Close()
+
+	api := memorystore.New(utils.NewLocalTestEnv(server.URL))
+	ctx := context.Background()
+
+	t.Run("Read By Priority And Discard", func(t *testing.T) {
+		for i, priority := range []float64{0, 5, 1} {
+			params := memorystore.NewEnqueueBuilder(universeID, "Jobs", json.RawMessage(strconv.Itoa(i))).WithPriority(priority).Build()
+
+			_, err := api.Enqueue(ctx, params)
+			require.NoError(t, err)
+		}
+
+		read, err := api.ReadQueue(ctx, memorystore.NewReadQueueBuilder(universeID, "Jobs").WithCount(2).Build())
+		require.NoError(t, err)
+		require.Len(t, read.Items, 2)
+		assert.JSONEq(t, "1", string(read.Items[0].Data))
+		assert.JSONEq(t, "2", string(read.Items[1].Data))
+
+		// Read items are hidden from other readers until discarded or the window ends
+		again, err := api.ReadQueue(ctx, memorystore.NewReadQueueBuilder(universeID, "Jobs").WithCount(2).Build())
+		require.NoError(t, err)
+		require.Len(t, again.Items, 1)
+		assert.JSONEq(t, "0", string(again.Items[0].Data))
+
+		discard := memorystore.DiscardQueueItemsParams{UniverseID: universeID, QueueID: "Jobs", ReadID: read.ReadID}
+		require.NoError(t, api.DiscardQueueItems(ctx, discard))
+		assert.Equal(t, 1, server.queueLength("Jobs"))
+	})
+
+	t.Run("Missing Read ID", func(t *testing.T) {
+		// An empty read has nothing to discard
+		read, err := api.ReadQueue(ctx, memorystore.NewReadQueueBuilder(universeID, "NoReadID").Build())
+		require.NoError(t, err)
+		assert.Empty(t, read.Items)
+
+		_, err = api.Enqueue(ctx, memorystore.NewEnqueueBuilder(universeID, "NoReadID", json.RawMessage(`1`)).Build())
+		require.NoError(t, err)
+
+		_, err = api.ReadQueue(ctx, memorystore.NewReadQueueBuilder(universeID, "NoReadID").Build())
+		require.ErrorIs(t, err, errs.ErrInvalidResponse)
+		assert.Contains(t, err.Error(), "ReadID")
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		_, err := api.ReadQueue(ctx, memorystore.NewReadQueueBuilder(universeID, "Jobs").WithCount(201).Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "Count")
+
+		_, err = api.Enqueue(ctx, memorystore.NewEnqueueBuilder(universeID, "Jobs", json.RawMessage(`{`)).Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+	})
+}
+
+func TestConsumer(t *testing.T) {
+	server := newMemoryStoreServer(t)
+	defer server.Close()
+
+	c, v := utils.NewLocalTestEnv(server.URL)
+	api := memorystore.New(c, v)
+
+	enqueue := func(t *testing.T, queue string, count int) {
+		t.Helper()
+
+		for i := range count {
+			_, err := api.Enqueue(context.Background(), memorystore.NewEnqueueBuilder(universeID, queue, json.RawMessage(strconv.Itoa(i))).Build())
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("Process All Items", func(t *testing.T) {
+		enqueue(t, "Work", 20)
+
+		var processed, active, peak atomic.Int32
+
+		params := memorystore.NewConsumerBuilder(universeID, "Work").
+			WithWorkers(3).
+			WithBatchSize(2).
+			WithPollInterval(10 * time.Millisecond).
+			Build()
+
+		consumer, err := memorystore.NewConsumer(api, v, params, func(_ context.Context, _ *types.QueueItem) error {
+			current := active.Add(1)
+			defer active.Add(-1)
+
+			for {
+				old := peak.Load()
+				if current <= old || peak.CompareAndSwap(old, current) {
+					break
+				}
+			}
+
+			time.Sleep(time.Millisecond)
+			processed.Add(1)
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+
+		go func() { done <- consumer.Run(ctx) }()
+
+		assert.Eventually(t, func() bool { return server.queueLength("Work") == 0 }, 5*time.Second, 10*time.Millisecond)
+		cancel()
+		require.ErrorIs(t, <-done, context.Canceled)
+
+		assert.Equal(t, int32(20), processed.Load())
+		assert.LessOrEqual(t, peak.Load(), int32(3))
+
+		for err := range consumer.Errors() {
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("Redeliver Failed Items", func(t *testing.T) {
+		enqueue(t, "Retry", 1)
+
+		var attempts atomic.Int32
+
+		params := memorystore.NewConsumerBuilder(universeID, "Retry").
+			WithWorkers(1).
+			WithInvisibilityWindow(time.Second).
+			WithPollInterval(10 * time.Millisecond).
+			Build()
+
+		consumer, err := memorystore.NewConsumer(api, v, params, func(_ context.Context, _ *types.QueueItem) error {
+			if attempts.Add(1) == 1 {
+				return errTemporary
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+
+		go func() { done <- consumer.Run(ctx) }()
+
+		assert.Eventually(t, func() bool { return server.queueLength("Retry") == 0 }, 5*time.Second, 10*time.Millisecond)
+		cancel()
+		<-done
+
+		assert.Equal(t, int32(2), attempts.Load())
+
+		err = <-consumer.Errors()
+		require.ErrorIs(t, err, memorystore.ErrHandlerFailed)
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		params := memorystore.NewConsumerBuilder(universeID, "Work").WithWorkers(0).Build()
+
+		_, err := memorystore.NewConsumer(api, v, params, nil)
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+	})
+}
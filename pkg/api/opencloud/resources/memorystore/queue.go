@@ -0,0 +1,226 @@
+package memorystore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// queueURL returns the URL of a queue.
+func queueURL(universeID int64, queueID string) string {
+	return fmt.Sprintf("%s/universes/%d/memory-store/queues/%s", types.OpenCloudEndpoint, universeID, url.PathEscape(queueID))
+}
+
+// Enqueue adds an item to a queue.
+// POST https://apis.roblox.com/cloud/v2/universes/{universe_id}/memory-store/queues/{queue_id}/items
+func (r *Resource) Enqueue(ctx context.Context, p EnqueueParams) (*types.QueueItem, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	if !json.Valid(p.Data) {
+		return nil, fmt.Errorf("%w: data is not valid JSON", errs.ErrInvalidRequest)
+	}
+
+	var item types.QueueItem
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(queueURL(p.UniverseID, p.QueueID) + "/items").
+		MarshalBody(struct {
+			Data     json.RawMessage `json:"data"`
+			Priority float64         `json:"priority"`
+			TTL      string          `json:"ttl"`
+		}{
+			Data:     p.Data,
+			Priority: p.Priority,
+			TTL:      types.FormatCloudDuration(p.TTL),
+		}).
+		Result(&item).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&item); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &item, nil
+}
+
+// ReadQueue reads items from the front of a queue, highest priority first. The items are
+// hidden from other readers for the invisibility window and return to the queue unless
+// they are discarded with the read ID before it ends.
+// GET https://apis.roblox.com/cloud/v2/universes/{universe_id}/memory-store/queues/{queue_id}/items:read
+func (r *Resource) ReadQueue(ctx context.Context, p ReadQueueParams) (*types.QueueReadResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var result types.QueueReadResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(queueURL(p.UniverseID, p.QueueID)+"/items:read").
+		Query("count", strconv.FormatInt(p.Count, 10)).
+		Query("allOrNothing", strconv.FormatBool(p.AllOrNothing)).
+		Query("invisibilityWindow", types.FormatCloudDuration(p.InvisibilityWindow)).
+		Result(&result).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	// Older responses only carry the data of the items
+	if len(result.Items) == 0 && len(result.Data) > 0 {
+		result.Items = make([]types.QueueItem, len(result.Data))
+		for i, data := range result.Data {
+			result.Items[i].Data = data
+		}
+	}
+
+	// An empty read has nothing to discard, so it needs no read ID
+	if len(result.Items) == 0 {
+		result.Items = nil
+	}
+
+	if err := r.validate.Struct(&result); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &result, nil
+}
+
+// DiscardQueueItems removes the items of a read from the queue.
+// POST https://apis.roblox.com/cloud/v2/universes/{universe_id}/memory-store/queues/{queue_id}/items:discard
+func (r *Resource) DiscardQueueItems(ctx context.Context, p DiscardQueueItemsParams) error {
+	if err := r.validate.Struct(p); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(queueURL(p.UniverseID, p.QueueID) + "/items:discard").
+		MarshalBody(p).
+		Do(ctx)
+	if err != nil {
+		return errs.HandleCloudError(resp, err)
+	}
+
+	_ = resp.Body.Close()
+
+	return nil
+}
+
+// EnqueueParams holds the parameters for adding an item to a queue.
+type EnqueueParams struct {
+	UniverseID int64           `json:"universeId" validate:"required,gt=0"`    // ID of the experience's universe
+	QueueID    string          `json:"queueId"    validate:"required,max=128"` // Name of the queue
+	Data       json.RawMessage `json:"data"       validate:"required"`         // JSON data of the item
+	Priority   float64         `json:"priority"`                               // Priority of the item; higher priorities are read first
+	TTL        time.Duration   `json:"ttl"        validate:"gte=1s,lte=1080h"` // How long the item lives, up to 45 days
+}
+
+// EnqueueBuilder is a builder for EnqueueParams.
+type EnqueueBuilder struct {
+	params EnqueueParams
+}
+
+// NewEnqueueBuilder creates a new EnqueueBuilder with default values.
+func NewEnqueueBuilder(universeID int64, queueID string, data json.RawMessage) *EnqueueBuilder {
+	return &EnqueueBuilder{
+		params: EnqueueParams{
+			UniverseID: universeID,
+			QueueID:    queueID,
+			Data:       data,
+			Priority:   0,
+			TTL:        time.Hour,
+		},
+	}
+}
+
+// WithPriority sets the priority of the item.
+func (b *EnqueueBuilder) WithPriority(priority float64) *EnqueueBuilder {
+	b.params.Priority = priority
+	return b
+}
+
+// WithTTL sets how long the item lives.
+func (b *EnqueueBuilder) WithTTL(ttl time.Duration) *EnqueueBuilder {
+	b.params.TTL = ttl
+	return b
+}
+
+// Build returns the EnqueueParams.
+func (b *EnqueueBuilder) Build() EnqueueParams {
+	return b.params
+}
+
+// ReadQueueParams holds the parameters for reading items from a queue.
+type ReadQueueParams struct {
+	UniverseID         int64         `json:"universeId"         validate:"required,gt=0"`    // ID of the experience's universe
+	QueueID            string        `json:"queueId"            validate:"required,max=128"` // Name of the queue
+	Count              int64         `json:"count"              validate:"min=1,max=200"`    // Maximum number of items to read
+	AllOrNothing       bool          `json:"allOrNothing"`                                   // Whether to read nothing unless Count items are available
+	InvisibilityWindow time.Duration `json:"invisibilityWindow" validate:"gte=1s"`           // How long the items are hidden from other readers
+}
+
+// ReadQueueBuilder is a builder for ReadQueueParams.
+type ReadQueueBuilder struct {
+	params ReadQueueParams
+}
+
+// NewReadQueueBuilder creates a new ReadQueueBuilder with default values.
+func NewReadQueueBuilder(universeID int64, queueID string) *ReadQueueBuilder {
+	return &ReadQueueBuilder{
+		params: ReadQueueParams{
+			UniverseID:         universeID,
+			QueueID:            queueID,
+			Count:              1,
+			AllOrNothing:       false,
+			InvisibilityWindow: 30 * time.Second,
+		},
+	}
+}
+
+// WithCount sets the maximum number of items to read.
+func (b *ReadQueueBuilder) WithCount(count int64) *ReadQueueBuilder {
+	b.params.Count = count
+	return b
+}
+
+// WithAllOrNothing sets whether to read nothing unless the full count is available.
+func (b *ReadQueueBuilder) WithAllOrNothing(allOrNothing bool) *ReadQueueBuilder {
+	b.params.AllOrNothing = allOrNothing
+	return b
+}
+
+// WithInvisibilityWindow sets how long the items are hidden from other readers.
+func (b *ReadQueueBuilder) WithInvisibilityWindow(invisibilityWindow time.Duration) *ReadQueueBuilder {
+	b.params.InvisibilityWindow = invisibilityWindow
+	return b
+}
+
+// Build returns the ReadQueueParams.
+func (b *ReadQueueBuilder) Build() ReadQueueParams {
+	return b.params
+}
+
+// DiscardQueueItemsParams holds the parameters for discarding the items of a read.
+type DiscardQueueItemsParams struct {
+	UniverseID int64  `json:"-"      validate:"required,gt=0"`    // ID of the experience's universe
+	QueueID    string `json:"-"      validate:"required,max=128"` // Name of the queue
+	ReadID     string `json:"readId" validate:"required"`         // ID of the read the items were returned by
+}
@@ -0,0 +1,40 @@
+package memorystore
+
+import (
+	"context"
+	"iter"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ResourceInterface defines the interface for memory store-related operations.
+type ResourceInterface interface {
+	ListSortedMapItems(ctx context.Context, p SortedMapItemsParams) (*types.SortedMapItemsResponse, error)
+	ListSortedMapItemsAll(ctx context.Context, p SortedMapItemsParams) iter.Seq2[*types.SortedMapItem, error]
+	GetSortedMapItem(ctx context.Context, p SortedMapItemParams) (*types.SortedMapItem, error)
+	CreateSortedMapItem(ctx context.Context, p WriteSortedMapItemParams) (*types.SortedMapItem, error)
+	UpdateSortedMapItem(ctx context.Context, p WriteSortedMapItemParams) (*types.SortedMapItem, error)
+	DeleteSortedMapItem(ctx context.Context, p SortedMapItemParams) error
+	Enqueue(ctx context.Context, p EnqueueParams) (*types.QueueItem, error)
+	ReadQueue(ctx context.Context, p ReadQueueParams) (*types.QueueReadResponse, error)
+	DiscardQueueItems(ctx context.Context, p DiscardQueueItemsParams) error
+}
+
+// Ensure Resource implements the ResourceInterface.
+var _ ResourceInterface = (*Resource)(nil)
+
+// Resource provides methods for interacting with the Open Cloud memory store endpoints.
+type Resource struct {
+	client   *client.Client
+	validate *validator.Validate
+}
+
+// New creates a new Resource with the specified client and validator.
+func New(client *client.Client, validate *validator.Validate) *Resource {
+	return &Resource{
+		client:   client,
+		validate: validate,
+	}
+}
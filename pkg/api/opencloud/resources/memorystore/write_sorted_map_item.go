@@ -0,0 +1,71 @@
+package memorystore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// CreateSortedMapItem adds an item to a sorted map. It fails if the item already exists.
+// POST https://apis.roblox.com/cloud/v2/universes/{universe_id}/memory-store/sorted-maps/{sorted_map_id}/items
+func (r *Resource) CreateSortedMapItem(ctx context.Context, p WriteSortedMapItemParams) (*types.SortedMapItem, error) {
+	req := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(sortedMapURL(p.UniverseID, p.SortedMapID)+"/items").
+		Query("id", p.ItemID)
+
+	return r.writeSortedMapItem(ctx, req, p)
+}
+
+// UpdateSortedMapItem replaces the value, TTL and sort key of a sorted map item.
+// If an etag is set, the update only succeeds if the item has not changed since it was read.
+// PATCH https://apis.roblox.com/cloud/v2/universes/{universe_id}/memory-store/sorted-maps/{sorted_map_id}/items/{item_id}
+func (r *Resource) UpdateSortedMapItem(ctx context.Context, p WriteSortedMapItemParams) (*types.SortedMapItem, error) {
+	req := r.client.NewRequest().
+		Method(http.MethodPatch).
+		URL(sortedMapItemURL(p.SortedMapItemParams)).
+		Query("allowMissing", strconv.FormatBool(p.AllowMissing))
+
+	return r.writeSortedMapItem(ctx, req, p)
+}
+
+// writeSortedMapItem validates the parameters and sends them with the request.
+func (r *Resource) writeSortedMapItem(ctx context.Context, req *client.Request, p WriteSortedMapItemParams) (*types.SortedMapItem, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	if !json.Valid(p.Value) {
+		return nil, fmt.Errorf("%w: value is not valid JSON", errs.ErrInvalidRequest)
+	}
+
+	var item types.SortedMapItem
+
+	resp, err := req.
+		MarshalBody(sortedMapItemBody{
+			Value:          p.Value,
+			TTL:            types.FormatCloudDuration(p.TTL),
+			Etag:           p.Etag,
+			StringSortKey:  p.StringSortKey,
+			NumericSortKey: p.NumericSortKey,
+		}).
+		Result(&item).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&item); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &item, nil
+}
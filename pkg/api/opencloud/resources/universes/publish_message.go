@@ -0,0 +1,62 @@
+package universes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+)
+
+// MaxMessageSize is the maximum size of a published message in bytes.
+const MaxMessageSize = 1024
+
+// PublishMessage publishes a message to a MessagingService topic, which is delivered to
+// every server of the universe subscribed to the topic.
+// POST https://apis.roblox.com/cloud/v2/universes/{universe_id}:publishMessage
+func (r *Resource) PublishMessage(ctx context.Context, p PublishMessageParams) error {
+	if err := r.validate.Struct(p); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(universeURL(p.UniverseID) + ":publishMessage").
+		MarshalBody(p).
+		Do(ctx)
+	if err != nil {
+		return errs.HandleCloudError(resp, err)
+	}
+
+	_ = resp.Body.Close()
+
+	return nil
+}
+
+// PublishMessageParams holds the parameters for publishing a message.
+type PublishMessageParams struct {
+	UniverseID int64  `json:"-"       validate:"required,gt=0"`              // ID of the experience's universe
+	Topic      string `json:"topic"   validate:"required,max=80,printascii"` // Topic to publish to, in printable ASCII
+	Message    string `json:"message" validate:"required,maxbytes=1024"`     // Message to publish, up to 1 KB
+}
+
+// PublishMessageBuilder is a builder for PublishMessageParams.
+type PublishMessageBuilder struct {
+	params PublishMessageParams
+}
+
+// NewPublishMessageBuilder creates a new PublishMessageBuilder with default values.
+func NewPublishMessageBuilder(universeID int64, topic, message string) *PublishMessageBuilder {
+	return &PublishMessageBuilder{
+		params: PublishMessageParams{
+			UniverseID: universeID,
+			Topic:      topic,
+			Message:    message,
+		},
+	}
+}
+
+// Build returns the PublishMessageParams.
+func (b *PublishMessageBuilder) Build() PublishMessageParams {
+	return b.params
+}
@@ -0,0 +1,40 @@
+package universes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ResourceInterface defines the interface for universe-related operations.
+type ResourceInterface interface {
+	PublishMessage(ctx context.Context, p PublishMessageParams) error
+	RestartServers(ctx context.Context, universeID int64) error
+	SendNotification(ctx context.Context, p NotificationParams) (*types.UserNotification, error)
+}
+
+// Ensure Resource implements the ResourceInterface.
+var _ ResourceInterface = (*Resource)(nil)
+
+// Resource provides methods for interacting with the Open Cloud universe endpoints.
+type Resource struct {
+	client   *client.Client
+	validate *validator.Validate
+}
+
+// New creates a new Resource with the specified client and validator.
+// The validator must have the validations of the validation package registered.
+func New(client *client.Client, validate *validator.Validate) *Resource {
+	return &Resource{
+		client:   client,
+		validate: validate,
+	}
+}
+
+// universeURL returns the URL of a universe.
+func universeURL(universeID int64) string {
+	return fmt.Sprintf("%s/universes/%d", types.OpenCloudEndpoint, universeID)
+}
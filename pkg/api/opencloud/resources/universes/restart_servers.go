@@ -0,0 +1,31 @@
+package universes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+)
+
+// RestartServers shuts down every server of the universe that is not on the latest
+// published version, so that players rejoin the latest version.
+// POST https://apis.roblox.com/cloud/v2/universes/{universe_id}:restartServers
+func (r *Resource) RestartServers(ctx context.Context, universeID int64) error {
+	if err := r.validate.Var(universeID, "required,gt=0"); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(universeURL(universeID) + ":restartServers").
+		MarshalBody(struct{}{}).
+		Do(ctx)
+	if err != nil {
+		return errs.HandleCloudError(resp, err)
+	}
+
+	_ = resp.Body.Close()
+
+	return nil
+}
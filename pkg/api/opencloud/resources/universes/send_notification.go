@@ -0,0 +1,152 @@
+package universes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// NotificationTypeMoment is the type of notifications about something happening in an experience.
+const NotificationTypeMoment = "MOMENT"
+
+// notificationBody is the body of a notification request.
+type notificationBody struct {
+	Source  notificationSource  `json:"source"`
+	Payload notificationPayload `json:"payload"`
+}
+
+// notificationSource is the universe sending a notification.
+type notificationSource struct {
+	Universe string `json:"universe"`
+}
+
+// notificationPayload is the content of a notification.
+type notificationPayload struct {
+	MessageID      string                           `json:"messageId"`
+	Type           string                           `json:"type"`
+	Parameters     map[string]notificationParameter `json:"parameters,omitempty"`
+	JoinExperience *notificationJoin                `json:"joinExperience,omitempty"`
+	AnalyticsData  *notificationAnalytics           `json:"analyticsData,omitempty"`
+}
+
+// notificationParameter is the value of a placeholder in a notification string.
+type notificationParameter struct {
+	StringValue string `json:"stringValue"`
+}
+
+// notificationJoin is the data passed to the experience when joining through a notification.
+type notificationJoin struct {
+	LaunchData string `json:"launchData"`
+}
+
+// notificationAnalytics is the analytics data of a notification.
+type notificationAnalytics struct {
+	Category string `json:"category"`
+}
+
+// SendNotification sends an experience notification to a user who opted in to the
+// universe's notifications. The text comes from the notification string given by
+// MessageID, with its {placeholders} filled from Parameters.
+// POST https://apis.roblox.com/cloud/v2/users/{user_id}/notifications
+func (r *Resource) SendNotification(ctx context.Context, p NotificationParams) (*types.UserNotification, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	parameters := make(map[string]notificationParameter, len(p.Parameters))
+	for key, value := range p.Parameters {
+		parameters[key] = notificationParameter{StringValue: value}
+	}
+
+	payload := notificationPayload{
+		MessageID:      p.MessageID,
+		Type:           p.Type,
+		Parameters:     parameters,
+		JoinExperience: nil,
+		AnalyticsData:  nil,
+	}
+
+	if p.LaunchData != "" {
+		payload.JoinExperience = &notificationJoin{LaunchData: p.LaunchData}
+	}
+
+	if p.Category != "" {
+		payload.AnalyticsData = &notificationAnalytics{Category: p.Category}
+	}
+
+	var notification types.UserNotification
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(fmt.Sprintf("%s/users/%d/notifications", types.OpenCloudEndpoint, p.UserID)).
+		MarshalBody(notificationBody{
+			Source:  notificationSource{Universe: fmt.Sprintf("universes/%d", p.UniverseID)},
+			Payload: payload,
+		}).
+		Result(&notification).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	return &notification, nil
+}
+
+// NotificationParams holds the parameters for sending an experience notification.
+type NotificationParams struct {
+	UserID     int64             `json:"userId"     validate:"required,gt=0"`         // ID of the user to notify
+	UniverseID int64             `json:"universeId" validate:"required,gt=0"`         // ID of the universe sending the notification
+	MessageID  string            `json:"messageId"  validate:"required,uuid"`         // Asset ID of the notification string
+	Type       string            `json:"type"       validate:"required,oneof=MOMENT"` // Type of the notification
+	Parameters map[string]string `json:"parameters" validate:"omitempty"`             // Values of the notification string's placeholders
+	LaunchData string            `json:"launchData" validate:"max=200"`               // Data passed to the experience when the user joins through the notification
+	Category   string            `json:"category"   validate:"omitempty"`             // Category to group the notification's analytics by
+}
+
+// NotificationBuilder is a builder for NotificationParams.
+type NotificationBuilder struct {
+	params NotificationParams
+}
+
+// NewNotificationBuilder creates a new NotificationBuilder with default values.
+func NewNotificationBuilder(userID, universeID int64, messageID string) *NotificationBuilder {
+	return &NotificationBuilder{
+		params: NotificationParams{
+			UserID:     userID,
+			UniverseID: universeID,
+			MessageID:  messageID,
+			Type:       NotificationTypeMoment,
+			Parameters: make(map[string]string),
+			LaunchData: "",
+			Category:   "",
+		},
+	}
+}
+
+// WithParameter sets the value of a placeholder in the notification string.
+func (b *NotificationBuilder) WithParameter(key, value string) *NotificationBuilder {
+	b.params.Parameters[key] = value
+	return b
+}
+
+// WithLaunchData sets the data passed to the experience when the user joins through the notification.
+func (b *NotificationBuilder) WithLaunchData(launchData string) *NotificationBuilder {
+	b.params.LaunchData = launchData
+	return b
+}
+
+// WithCategory sets the category to group the notification's analytics by.
+func (b *NotificationBuilder) WithCategory(category string) *NotificationBuilder {
+	b.params.Category = category
+	return b
+}
+
+// Build returns the NotificationParams.
+func (b *NotificationBuilder) Build() NotificationParams {
+	return b.params
+}
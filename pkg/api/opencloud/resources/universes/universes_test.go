@@ -0,0 +1,176 @@
+package universes_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/resources/universes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	universeID = int64(1)
+	messageID  = "3f1a2b4c-5d6e-4f70-8a9b-0c1d2e3f4a5b"
+)
+
+// universeServer is a stand-in for the Open Cloud universe endpoints that records what it receives.
+type universeServer struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	messages      map[string][]string       // Published messages by topic
+	restarts      int                       // Number of server restarts
+	notifications map[string]map[string]any // Notification bodies by user ID
+}
+
+func newUniverseServer(t *testing.T) *universeServer {
+	t.Helper()
+
+	s := &universeServer{
+		messages:      make(map[string][]string),
+		notifications: make(map[string]map[string]any),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /cloud/v2/universes/{universe}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		universe, method, _ := strings.Cut(r.PathValue("universe"), ":")
+		if universe != "1" {
+			utils.WriteCloudError(w, http.StatusNotFound, "NOT_FOUND", "Universe not found.")
+			return
+		}
+
+		switch method {
+		case "publishMessage":
+			var body struct {
+				Topic   string `json:"topic"`
+				Message string `json:"message"`
+			}
+
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			s.messages[body.Topic] = append(s.messages[body.Topic], body.Message)
+		case "restartServers":
+			s.restarts++
+		default:
+			utils.WriteCloudError(w, http.StatusNotFound, "NOT_FOUND", "Unknown method.")
+			return
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("POST /cloud/v2/users/{user}/notifications", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		var body map[string]any
+
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		s.notifications[r.PathValue("user")] = body
+
+		_, _ = w.Write([]byte(`{"path":"users/` + r.PathValue("user") + `/notifications/notification-1","id":"notification-1"}`))
+	})
+
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+func TestPublishMessage(t *testing.T) {
+	server := newUniverseServer(t)
+	defer server.Close()
+
+	api := universes.New(utils.NewLocalTestEnv(server.URL))
+	ctx := context.Background()
+
+	t.Run("Publish Message", func(t *testing.T) {
+		err := api.PublishMessage(ctx, universes.NewPublishMessageBuilder(universeID, "Announcements", "hello").Build())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"hello"}, server.messages["Announcements"])
+	})
+
+	t.Run("Unknown Universe", func(t *testing.T) {
+		err := api.PublishMessage(ctx, universes.NewPublishMessageBuilder(2, "Announcements", "hello").Build())
+
+		var cloudErr *errs.CloudError
+		require.ErrorAs(t, err, &cloudErr)
+		assert.Equal(t, errs.CloudErrorNotFound, cloudErr.Code)
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		err := api.PublishMessage(ctx, universes.NewPublishMessageBuilder(universeID, strings.Repeat("a", 81), "hello").Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "Topic")
+
+		err = api.PublishMessage(ctx, universes.NewPublishMessageBuilder(universeID, "Announcements\n", "hello").Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "printascii")
+
+		// Within the character limit, but over the byte limit
+		err = api.PublishMessage(ctx, universes.NewPublishMessageBuilder(universeID, "Announcements", strings.Repeat("é", 600)).Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "maxbytes")
+		assert.Len(t, server.messages["Announcements"], 1)
+	})
+}
+
+func TestRestartServers(t *testing.T) {
+	server := newUniverseServer(t)
+	defer server.Close()
+
+	api := universes.New(utils.NewLocalTestEnv(server.URL))
+
+	require.NoError(t, api.RestartServers(context.Background(), universeID))
+	assert.Equal(t, 1, server.restarts)
+
+	require.ErrorIs(t, api.RestartServers(context.Background(), 0), errs.ErrInvalidRequest)
+}
+
+func TestSendNotification(t *testing.T) {
+	server := newUniverseServer(t)
+	defer server.Close()
+
+	api := universes.New(utils.NewLocalTestEnv(server.URL))
+	ctx := context.Background()
+
+	t.Run("Send Notification", func(t *testing.T) {
+		params := universes.NewNotificationBuilder(42, universeID, messageID).
+			WithParameter("points", "100").
+			WithLaunchData("reward").
+			Build()
+
+		notification, err := api.SendNotification(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, "notification-1", notification.ID)
+
+		body := server.notifications["42"]
+		assert.Equal(t, map[string]any{"universe": "universes/1"}, body["source"])
+		assert.Equal(t, map[string]any{
+			"messageId":      messageID,
+			"type":           universes.NotificationTypeMoment,
+			"parameters":     map[string]any{"points": map[string]any{"stringValue": "100"}},
+			"joinExperience": map[string]any{"launchData": "reward"},
+		}, body["payload"])
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		_, err := api.SendNotification(ctx, universes.NewNotificationBuilder(42, universeID, "not-a-uuid").Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "MessageID")
+
+		params := universes.NewNotificationBuilder(42, universeID, messageID).WithLaunchData(strings.Repeat("a", 201)).Build()
+
+		_, err = api.SendNotification(ctx, params)
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "LaunchData")
+	})
+}
@@ -0,0 +1,39 @@
+package userrestrictions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetRestriction fetches the restriction of a user in a universe or one of its places.
+// Users who were never restricted have an inactive restriction.
+// GET https://apis.roblox.com/cloud/v2/universes/{universe_id}/user-restrictions/{user_id}
+// GET https://apis.roblox.com/cloud/v2/universes/{universe_id}/places/{place_id}/user-restrictions/{user_id}
+func (r *Resource) GetRestriction(ctx context.Context, p RestrictionParams) (*types.UserRestriction, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var restriction types.UserRestriction
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(restrictionURL(p)).
+		Result(&restriction).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&restriction); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &restriction, nil
+}
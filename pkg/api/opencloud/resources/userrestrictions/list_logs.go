@@ -0,0 +1,127 @@
+package userrestrictions
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"strings"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/pagination"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ListLogs fetches a page of the changes made to the user restrictions of a universe and
+// its places, newest first.
+// GET https://apis.roblox.com/cloud/v2/universes/{universe_id}/user-restrictions:listLogs
+func (r *Resource) ListLogs(ctx context.Context, p LogsParams) (*types.UserRestrictionLogsResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var logs types.UserRestrictionLogsResponse
+
+	req := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(restrictionsURL(p.UniverseID, 0) + ":listLogs")
+	if filter := logsFilter(p); filter != "" {
+		req = req.Query("filter", filter)
+	}
+
+	resp, err := pagination.Query(req, p.Params).
+		Result(&logs).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	return &logs, nil
+}
+
+// ListLogsAll returns an iterator over every restriction log of a universe,
+// starting at the page token in the parameters and following pages until the last one.
+// Iteration stops at the first error, which is yielded with a nil log.
+func (r *Resource) ListLogsAll(ctx context.Context, p LogsParams) iter.Seq2[*types.UserRestrictionLog, error] {
+	return pagination.All(ctx, p.Params, func(ctx context.Context, page pagination.Params) ([]types.UserRestrictionLog, string, error) {
+		p.Params = page
+
+		result, err := r.ListLogs(ctx, p)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return result.Logs, result.NextPageToken, nil
+	})
+}
+
+// logsFilter returns the filter expression selecting the logs of a user or place.
+func logsFilter(p LogsParams) string {
+	var conditions []string
+	if p.UserID != 0 {
+		conditions = append(conditions, fmt.Sprintf("user == 'users/%d'", p.UserID))
+	}
+
+	if p.PlaceID != 0 {
+		conditions = append(conditions, fmt.Sprintf("place == 'places/%d'", p.PlaceID))
+	}
+
+	return strings.Join(conditions, " && ")
+}
+
+// LogsParams holds the parameters for listing user restriction logs.
+type LogsParams struct {
+	pagination.Params
+
+	UniverseID int64 `json:"universeId" validate:"required,gt=0"`  // ID of the experience's universe
+	UserID     int64 `json:"userId"     validate:"omitempty,gt=0"` // ID of the user to list the logs of, or 0 for all users
+	PlaceID    int64 `json:"placeId"    validate:"omitempty,gt=0"` // ID of the place to list the logs of, or 0 for all places
+}
+
+// LogsBuilder is a builder for LogsParams.
+type LogsBuilder struct {
+	params LogsParams
+}
+
+// NewLogsBuilder creates a new LogsBuilder with default values.
+func NewLogsBuilder(universeID int64) *LogsBuilder {
+	return &LogsBuilder{
+		params: LogsParams{
+			Params:     pagination.Params{MaxPageSize: 10, PageToken: ""},
+			UniverseID: universeID,
+			UserID:     0,
+			PlaceID:    0,
+		},
+	}
+}
+
+// WithUserID only lists the logs of a user.
+func (b *LogsBuilder) WithUserID(userID int64) *LogsBuilder {
+	b.params.UserID = userID
+	return b
+}
+
+// WithPlaceID only lists the logs of a place.
+func (b *LogsBuilder) WithPlaceID(placeID int64) *LogsBuilder {
+	b.params.PlaceID = placeID
+	return b
+}
+
+// WithMaxPageSize sets the maximum number of logs per page.
+func (b *LogsBuilder) WithMaxPageSize(maxPageSize int64) *LogsBuilder {
+	b.params.MaxPageSize = maxPageSize
+	return b
+}
+
+// WithPageToken sets the token of the page to fetch.
+func (b *LogsBuilder) WithPageToken(pageToken string) *LogsBuilder {
+	b.params.PageToken = pageToken
+	return b
+}
+
+// Build returns the LogsParams.
+func (b *LogsBuilder) Build() LogsParams {
+	return b.params
+}
@@ -0,0 +1,105 @@
+package userrestrictions
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/pagination"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ListRestrictions fetches a page of the user restrictions of a universe or one of its places.
+// GET https://apis.roblox.com/cloud/v2/universes/{universe_id}/user-restrictions
+// GET https://apis.roblox.com/cloud/v2/universes/{universe_id}/places/{place_id}/user-restrictions
+func (r *Resource) ListRestrictions(ctx context.Context, p RestrictionsParams) (*types.UserRestrictionsResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var restrictions types.UserRestrictionsResponse
+
+	req := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(restrictionsURL(p.UniverseID, p.PlaceID))
+
+	resp, err := pagination.Query(req, p.Params).
+		Result(&restrictions).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&restrictions); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &restrictions, nil
+}
+
+// ListRestrictionsAll returns an iterator over every user restriction of a universe or one of its places,
+// starting at the page token in the parameters and following pages until the last one.
+// Iteration stops at the first error, which is yielded with a nil restriction.
+func (r *Resource) ListRestrictionsAll(ctx context.Context, p RestrictionsParams) iter.Seq2[*types.UserRestriction, error] {
+	return pagination.All(ctx, p.Params, func(ctx context.Context, page pagination.Params) ([]types.UserRestriction, string, error) {
+		p.Params = page
+
+		result, err := r.ListRestrictions(ctx, p)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return result.UserRestrictions, result.NextPageToken, nil
+	})
+}
+
+// RestrictionsParams holds the parameters for listing user restrictions.
+type RestrictionsParams struct {
+	pagination.Params
+
+	UniverseID int64 `json:"universeId" validate:"required,gt=0"`  // ID of the experience's universe
+	PlaceID    int64 `json:"placeId"    validate:"omitempty,gt=0"` // ID of the place, or 0 for the whole universe
+}
+
+// RestrictionsBuilder is a builder for RestrictionsParams.
+type RestrictionsBuilder struct {
+	params RestrictionsParams
+}
+
+// NewRestrictionsBuilder creates a new RestrictionsBuilder with default values.
+func NewRestrictionsBuilder(universeID int64) *RestrictionsBuilder {
+	return &RestrictionsBuilder{
+		params: RestrictionsParams{
+			Params:     pagination.Params{MaxPageSize: 10, PageToken: ""},
+			UniverseID: universeID,
+			PlaceID:    0,
+		},
+	}
+}
+
+// WithPlaceID lists the restrictions of a place of the universe instead.
+func (b *RestrictionsBuilder) WithPlaceID(placeID int64) *RestrictionsBuilder {
+	b.params.PlaceID = placeID
+	return b
+}
+
+// WithMaxPageSize sets the maximum number of restrictions per page.
+func (b *RestrictionsBuilder) WithMaxPageSize(maxPageSize int64) *RestrictionsBuilder {
+	b.params.MaxPageSize = maxPageSize
+	return b
+}
+
+// WithPageToken sets the token of the page to fetch.
+func (b *RestrictionsBuilder) WithPageToken(pageToken string) *RestrictionsBuilder {
+	b.params.PageToken = pageToken
+	return b
+}
+
+// Build returns the RestrictionsParams.
+func (b *RestrictionsBuilder) Build() RestrictionsParams {
+	return b.params
+}
@@ -0,0 +1,37 @@
+package userrestrictions
+
+import (
+	"context"
+	"iter"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ResourceInterface defines the interface for user restriction-related operations.
+type ResourceInterface interface {
+	GetRestriction(ctx context.Context, p RestrictionParams) (*types.UserRestriction, error)
+	ListRestrictions(ctx context.Context, p RestrictionsParams) (*types.UserRestrictionsResponse, error)
+	ListRestrictionsAll(ctx context.Context, p RestrictionsParams) iter.Seq2[*types.UserRestriction, error]
+	UpdateRestriction(ctx context.Context, p UpdateRestrictionParams) (*types.UserRestriction, error)
+	ListLogs(ctx context.Context, p LogsParams) (*types.UserRestrictionLogsResponse, error)
+	ListLogsAll(ctx context.Context, p LogsParams) iter.Seq2[*types.UserRestrictionLog, error]
+}
+
+// Ensure Resource implements the ResourceInterface.
+var _ ResourceInterface = (*Resource)(nil)
+
+// Resource provides methods for interacting with the Open Cloud user restriction endpoints.
+type Resource struct {
+	client   *client.Client
+	validate *validator.Validate
+}
+
+// New creates a new Resource with the specified client and validator.
+func New(client *client.Client, validate *validator.Validate) *Resource {
+	return &Resource{
+		client:   client,
+		validate: validate,
+	}
+}
@@ -0,0 +1,57 @@
+package userrestrictions
+
+import (
+	"fmt"
+
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// restrictionsURL returns the URL of the restrictions of a universe, or of one of its
+// places if a place ID is given.
+func restrictionsURL(universeID, placeID int64) string {
+	base := fmt.Sprintf("%s/universes/%d", types.OpenCloudEndpoint, universeID)
+	if placeID != 0 {
+		base += fmt.Sprintf("/places/%d", placeID)
+	}
+
+	return base + "/user-restrictions"
+}
+
+// restrictionURL returns the URL of the restriction of a user.
+func restrictionURL(p RestrictionParams) string {
+	return fmt.Sprintf("%s/%d", restrictionsURL(p.UniverseID, p.PlaceID), p.UserID)
+}
+
+// RestrictionParams identifies the restriction of a user in a universe or one of its places.
+type RestrictionParams struct {
+	UniverseID int64 `json:"universeId" validate:"required,gt=0"`  // ID of the experience's universe
+	PlaceID    int64 `json:"placeId"    validate:"omitempty,gt=0"` // ID of the place, or 0 for the whole universe
+	UserID     int64 `json:"userId"     validate:"required,gt=0"`  // ID of the restricted user
+}
+
+// RestrictionBuilder is a builder for RestrictionParams.
+type RestrictionBuilder struct {
+	params RestrictionParams
+}
+
+// NewRestrictionBuilder creates a new RestrictionBuilder with default values.
+func NewRestrictionBuilder(universeID, userID int64) *RestrictionBuilder {
+	return &RestrictionBuilder{
+		params: RestrictionParams{
+			UniverseID: universeID,
+			PlaceID:    0,
+			UserID:     userID,
+		},
+	}
+}
+
+// WithPlaceID scopes the restriction to a place of the universe.
+func (b *RestrictionBuilder) WithPlaceID(placeID int64) *RestrictionBuilder {
+	b.params.PlaceID = placeID
+	return b
+}
+
+// Build returns the RestrictionParams.
+func (b *RestrictionBuilder) Build() RestrictionParams {
+	return b.params
+}
@@ -0,0 +1,128 @@
+package userrestrictions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// UpdateRestriction bans or unbans a user from a universe or one of its places.
+// A universe ban applies to all of its places, while a place ban only applies to that place.
+// PATCH https://apis.roblox.com/cloud/v2/universes/{universe_id}/user-restrictions/{user_id}
+// PATCH https://apis.roblox.com/cloud/v2/universes/{universe_id}/places/{place_id}/user-restrictions/{user_id}
+func (r *Resource) UpdateRestriction(ctx context.Context, p UpdateRestrictionParams) (*types.UserRestriction, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var restriction types.UserRestriction
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPatch).
+		URL(restrictionURL(p.RestrictionParams)).
+		Query("updateMask", "gameJoinRestriction").
+		MarshalBody(struct {
+			GameJoinRestriction types.GameJoinRestriction `json:"gameJoinRestriction"`
+		}{
+			GameJoinRestriction: types.GameJoinRestriction{
+				Active:             p.Active,
+				StartTime:          time.Time{},
+				Duration:           p.Duration,
+				PrivateReason:      p.PrivateReason,
+				DisplayReason:      p.DisplayReason,
+				ExcludeAltAccounts: p.ExcludeAltAccounts,
+				Inherited:          false,
+			},
+		}).
+		Result(&restriction).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleCloudError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&restriction); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &restriction, nil
+}
+
+// UpdateRestrictionParams holds the parameters for banning or unbanning a user.
+type UpdateRestrictionParams struct {
+	RestrictionParams
+
+	Active             bool          `json:"active"`                                           // Whether to ban or unban the user
+	Duration           time.Duration `json:"duration"           validate:"gte=0s"`             // How long the ban lasts, or 0 for a permanent ban
+	PrivateReason      string        `json:"privateReason"      validate:"omitempty,max=1000"` // Reason only shown to the experience's moderators
+	DisplayReason      string        `json:"displayReason"      validate:"omitempty,max=400"`  // Reason shown to the banned user
+	ExcludeAltAccounts bool          `json:"excludeAltAccounts"`                               // Whether to leave the user's alternate accounts unbanned
+}
+
+// UpdateRestrictionBuilder is a builder for UpdateRestrictionParams.
+type UpdateRestrictionBuilder struct {
+	params UpdateRestrictionParams
+}
+
+// NewBanBuilder creates a new UpdateRestrictionBuilder that permanently bans the user,
+// including their alternate accounts.
+func NewBanBuilder(restriction RestrictionParams) *UpdateRestrictionBuilder {
+	return &UpdateRestrictionBuilder{
+		params: UpdateRestrictionParams{
+			RestrictionParams:  restriction,
+			Active:             true,
+			Duration:           0,
+			PrivateReason:      "",
+			DisplayReason:      "",
+			ExcludeAltAccounts: false,
+		},
+	}
+}
+
+// NewUnbanBuilder creates a new UpdateRestrictionBuilder that lifts the ban of the user.
+func NewUnbanBuilder(restriction RestrictionParams) *UpdateRestrictionBuilder {
+	return &UpdateRestrictionBuilder{
+		params: UpdateRestrictionParams{
+			RestrictionParams:  restriction,
+			Active:             false,
+			Duration:           0,
+			PrivateReason:      "",
+			DisplayReason:      "",
+			ExcludeAltAccounts: false,
+		},
+	}
+}
+
+// WithDuration sets how long the ban lasts.
+func (b *UpdateRestrictionBuilder) WithDuration(duration time.Duration) *UpdateRestrictionBuilder {
+	b.params.Duration = duration
+	return b
+}
+
+// WithPrivateReason sets the reason only shown to the experience's moderators.
+func (b *UpdateRestrictionBuilder) WithPrivateReason(privateReason string) *UpdateRestrictionBuilder {
+	b.params.PrivateReason = privateReason
+	return b
+}
+
+// WithDisplayReason sets the reason shown to the banned user.
+func (b *UpdateRestrictionBuilder) WithDisplayReason(displayReason string) *UpdateRestrictionBuilder {
+	b.params.DisplayReason = displayReason
+	return b
+}
+
+// WithExcludeAltAccounts sets whether to leave the user's alternate accounts unbanned.
+func (b *UpdateRestrictionBuilder) WithExcludeAltAccounts(excludeAltAccounts bool) *UpdateRestrictionBuilder {
+	b.params.ExcludeAltAccounts = excludeAltAccounts
+	return b
+}
+
+// Build returns the UpdateRestrictionParams.
+func (b *UpdateRestrictionBuilder) Build() UpdateRestrictionParams {
+	return b.params
+}
@@ -0,0 +1,291 @@
+package userrestrictions_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/opencloud/resources/userrestrictions"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	universeID = int64(1)
+	placeID    = int64(10)
+)
+
+// restrictionServer is an in-memory stand-in for the Open Cloud user restriction endpoints.
+type restrictionServer struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	restrictions map[string]types.UserRestriction // Restrictions by path
+	logs         []json.RawMessage                // Encoded logs, oldest first
+}
+
+func newRestrictionServer(t *testing.T) *restrictionServer {
+	t.Helper()
+
+	s := &restrictionServer{restrictions: make(map[string]types.UserRestriction)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		path := strings.TrimPrefix(r.URL.Path, "/cloud/v2/")
+
+		switch {
+		case strings.HasSuffix(path, "/user-restrictions:listLogs"):
+			s.listLogs(w, r)
+		case strings.HasSuffix(path, "/user-restrictions"):
+			s.listRestrictions(w, r, path)
+		case r.Method == http.MethodPatch:
+			s.updateRestriction(w, r, path)
+		default:
+			restriction, ok := s.restrictions[path]
+			if !ok {
+				restriction = types.UserRestriction{Path: path, User: "users/" + path[strings.LastIndex(path, "/")+1:]}
+			}
+
+			utils.WriteJSON(w, restriction)
+		}
+	}))
+
+	return s
+}
+
+func (s *restrictionServer) listRestrictions(w http.ResponseWriter, r *http.Request, path string) {
+	restrictions := make([]types.UserRestriction, 0, len(s.restrictions))
+	for restrictionPath, restriction := range s.restrictions {
+		if strings.TrimSuffix(restrictionPath, "/"+strconv.FormatInt(restriction.UserID(), 10)) == path {
+			restrictions = append(restrictions, restriction)
+		}
+	}
+
+	slices.SortFunc(restrictions, func(a, b types.UserRestriction) int { return strings.Compare(a.Path, b.Path) })
+
+	start, _ := strconv.Atoi(r.URL.Query().Get("pageToken"))
+	size, _ := strconv.Atoi(r.URL.Query().Get("maxPageSize"))
+	end := min(start+size, len(restrictions))
+
+	page := types.UserRestrictionsResponse{UserRestrictions: restrictions[start:end]}
+	if end < len(restrictions) {
+		page.NextPageToken = strconv.Itoa(end)
+	}
+
+	utils.WriteJSON(w, page)
+}
+
+func (s *restrictionServer) updateRestriction(w http.ResponseWriter, r *http.Request, path string) {
+	if r.URL.Query().Get("updateMask") != "gameJoinRestriction" {
+		utils.WriteCloudError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "Invalid update mask.")
+		return
+	}
+
+	var body struct {
+		GameJoinRestriction types.GameJoinRestriction `json:"gameJoinRestriction"`
+	}
+
+	var raw struct {
+		GameJoinRestriction struct {
+			Duration  string  `json:"duration"`
+			StartTime *string `json:"startTime"`
+			Inherited *bool   `json:"inherited"`
+		} `json:"gameJoinRestriction"`
+	}
+
+	encoded, _ := io.ReadAll(r.Body)
+	if err := json.Unmarshal(encoded, &body); err != nil {
+		utils.WriteCloudError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+		return
+	}
+
+	_ = json.Unmarshal(encoded, &raw)
+
+	// Like Open Cloud, reject output-only fields
+	if raw.GameJoinRestriction.StartTime != nil || raw.GameJoinRestriction.Inherited != nil {
+		utils.WriteCloudError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "Output-only field set.")
+		return
+	}
+
+	user := path[strings.LastIndex(path, "/")+1:]
+	restriction := body.GameJoinRestriction
+	restriction.StartTime = time.Now().UTC().Truncate(time.Second)
+
+	s.restrictions[path] = types.UserRestriction{
+		Path:                path,
+		UpdateTime:          restriction.StartTime,
+		User:                "users/" + user,
+		GameJoinRestriction: restriction,
+	}
+
+	place := ""
+	if _, rest, ok := strings.Cut(path, "/places/"); ok {
+		place = "places/" + rest[:strings.Index(rest, "/")]
+	}
+
+	log, _ := json.Marshal(map[string]any{
+		"user":               "users/" + user,
+		"place":              place,
+		"moderator":          map[string]any{"robloxUser": "users/99"},
+		"createTime":         restriction.StartTime,
+		"active":             restriction.Active,
+		"startTime":          restriction.StartTime,
+		"duration":           raw.GameJoinRestriction.Duration,
+		"privateReason":      restriction.PrivateReason,
+		"displayReason":      restriction.DisplayReason,
+		"excludeAltAccounts": restriction.ExcludeAltAccounts,
+	})
+	s.logs = append(s.logs, log)
+
+	utils.WriteJSON(w, s.restrictions[path])
+}
+
+func (s *restrictionServer) listLogs(w http.ResponseWriter, r *http.Request) {
+	logs := make([]json.RawMessage, 0, len(s.logs))
+
+	for _, log := range slices.Backward(s.logs) {
+		var fields struct {
+			User  string `json:"user"`
+			Place string `json:"place"`
+		}
+
+		_ = json.Unmarshal(log, &fields)
+
+		filter := r.URL.Query().Get("filter")
+		if filter != "" && !strings.Contains(filter, fmt.Sprintf("user == '%s'", fields.User)) &&
+			!strings.Contains(filter, fmt.Sprintf("place == '%s'", fields.Place)) {
+			continue
+		}
+
+		logs = append(logs, log)
+	}
+
+	utils.WriteJSON(w, map[string]any{"logs": logs})
+}
+
+func TestUserRestrictions(t *testing.T) {
+	server := newRestrictionServer(t)
+	defer server.Close()
+
+	api := userrestrictions.New(utils.NewLocalTestEnv(server.URL))
+	ctx := context.Background()
+
+	t.Run("Ban And Get User", func(t *testing.T) {
+		restriction := userrestrictions.NewRestrictionBuilder(universeID, 100).Build()
+		params := userrestrictions.NewBanBuilder(restriction).
+			WithDuration(36 * time.Hour).
+			WithDisplayReason("Exploiting").
+			WithPrivateReason("Speed hacks in round 3").
+			WithExcludeAltAccounts(true).
+			Build()
+
+		banned, err := api.UpdateRestriction(ctx, params)
+		require.NoError(t, err)
+		assert.True(t, banned.GameJoinRestriction.Active)
+		assert.Equal(t, 36*time.Hour, banned.GameJoinRestriction.Duration)
+
+		fetched, err := api.GetRestriction(ctx, restriction)
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), fetched.UserID())
+		assert.Equal(t, "Exploiting", fetched.GameJoinRestriction.DisplayReason)
+		assert.Equal(t, "Speed hacks in round 3", fetched.GameJoinRestriction.PrivateReason)
+		assert.True(t, fetched.GameJoinRestriction.ExcludeAltAccounts)
+		assert.Equal(t, fetched.GameJoinRestriction.StartTime.Add(36*time.Hour), fetched.GameJoinRestriction.EndTime())
+	})
+
+	t.Run("Ban User From Place", func(t *testing.T) {
+		restriction := userrestrictions.NewRestrictionBuilder(universeID, 200).WithPlaceID(placeID).Build()
+
+		banned, err := api.UpdateRestriction(ctx, userrestrictions.NewBanBuilder(restriction).Build())
+		require.NoError(t, err)
+		assert.Equal(t, "universes/1/places/10/user-restrictions/200", banned.Path)
+		assert.Zero(t, banned.GameJoinRestriction.Duration)
+		assert.True(t, banned.GameJoinRestriction.EndTime().IsZero())
+
+		universe, err := api.GetRestriction(ctx, userrestrictions.NewRestrictionBuilder(universeID, 200).Build())
+		require.NoError(t, err)
+		assert.False(t, universe.GameJoinRestriction.Active)
+	})
+
+	t.Run("List Restrictions", func(t *testing.T) {
+		for userID := range int64(3) {
+			restriction := userrestrictions.NewRestrictionBuilder(universeID, 300+userID).Build()
+
+			_, err := api.UpdateRestriction(ctx, userrestrictions.NewBanBuilder(restriction).Build())
+			require.NoError(t, err)
+		}
+
+		var userIDs []int64
+
+		for restriction, err := range api.ListRestrictionsAll(ctx, userrestrictions.NewRestrictionsBuilder(universeID).WithMaxPageSize(2).Build()) {
+			require.NoError(t, err)
+
+			userIDs = append(userIDs, restriction.UserID())
+		}
+
+		assert.Equal(t, []int64{100, 300, 301, 302}, userIDs)
+
+		place, err := api.ListRestrictions(ctx, userrestrictions.NewRestrictionsBuilder(universeID).WithPlaceID(placeID).Build())
+		require.NoError(t, err)
+		require.Len(t, place.UserRestrictions, 1)
+		assert.Equal(t, int64(200), place.UserRestrictions[0].UserID())
+	})
+
+	t.Run("Unban And List Logs", func(t *testing.T) {
+		restriction := userrestrictions.NewRestrictionBuilder(universeID, 100).Build()
+
+		unbanned, err := api.UpdateRestriction(ctx, userrestrictions.NewUnbanBuilder(restriction).Build())
+		require.NoError(t, err)
+		assert.False(t, unbanned.GameJoinRestriction.Active)
+
+		logs, err := api.ListLogs(ctx, userrestrictions.NewLogsBuilder(universeID).WithUserID(100).Build())
+		require.NoError(t, err)
+		require.Len(t, logs.Logs, 2)
+
+		assert.Equal(t, int64(100), logs.Logs[0].UserID())
+		assert.False(t, logs.Logs[0].Active)
+		assert.True(t, logs.Logs[1].Active)
+		assert.Equal(t, 36*time.Hour, logs.Logs[1].Duration)
+		assert.Equal(t, "Exploiting", logs.Logs[1].DisplayReason)
+		assert.Equal(t, int64(99), logs.Logs[1].Moderator.UserID())
+
+		count := 0
+
+		for log, err := range api.ListLogsAll(ctx, userrestrictions.NewLogsBuilder(universeID).WithPlaceID(placeID).Build()) {
+			require.NoError(t, err)
+			assert.Equal(t, "places/10", log.Place)
+
+			count++
+		}
+
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		restriction := userrestrictions.NewRestrictionBuilder(universeID, 100).Build()
+
+		_, err := api.UpdateRestriction(ctx, userrestrictions.NewBanBuilder(restriction).WithDuration(-time.Hour).Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "Duration")
+
+		_, err = api.UpdateRestriction(ctx, userrestrictions.NewBanBuilder(restriction).WithDisplayReason(strings.Repeat("a", 401)).Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "DisplayReason")
+
+		_, err = api.GetRestriction(ctx, userrestrictions.NewRestrictionBuilder(universeID, 0).Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+	})
+}
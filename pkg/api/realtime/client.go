@@ -0,0 +1,424 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/roapi.go/internal/backoff"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+var (
+	ErrHandshakeFailed       = errors.New("realtime handshake failed")
+	ErrServerClosed          = errors.New("realtime server closed the connection")
+	ErrServerTimeout         = errors.New("realtime server stopped responding")
+	ErrReconnectNotAllowed   = errors.New("realtime server does not allow reconnecting")
+	ErrUnexpectedMessageType = errors.New("unexpected websocket message type")
+)
+
+// maxMessageSize caps the size of a single websocket message.
+const maxMessageSize = 1 << 20
+
+// CookieSource provides the cookie each connection authenticates with.
+// auth.Middleware implements it, so the client can share the API's cookie pool.
+type CookieSource interface {
+	NextCookie() (string, error)
+}
+
+// Client maintains a connection to the realtime notification hub and decodes the
+// notifications it receives into events.
+//
+// Each connection uses the next cookie from the CookieSource. Lost connections are
+// re-established with exponential backoff, and ConnectedEvent and DisconnectedEvent
+// mark the gaps during which notifications may have been missed.
+type Client struct {
+	cookies CookieSource
+	params  ClientParams
+	events  chan Event
+	errors  chan error
+}
+
+// NewClient creates a new Client that authenticates with cookies from the given source.
+func NewClient(cookies CookieSource, validate *validator.Validate, p ClientParams) (*Client, error) {
+	if err := validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	return &Client{
+		cookies: cookies,
+		params:  p,
+		events:  make(chan Event, p.BufferSize),
+		errors:  make(chan error, p.BufferSize),
+	}, nil
+}
+
+// Events returns the channel events are sent on.
+// It is closed when Run returns.
+func (c *Client) Events() <-chan Event {
+	return c.events
+}
+
+// Errors returns the channel connection and decoding errors are reported on.
+// Errors are dropped when the channel is full, and it is closed when Run returns.
+func (c *Client) Errors() <-chan error {
+	return c.errors
+}
+
+// Run connects to the hub and reconnects whenever the connection is lost, until the
+// context is done or the server forbids reconnecting. It must only be called once.
+func (c *Client) Run(ctx context.Context) error {
+	defer close(c.events)
+	defer close(c.errors)
+
+	delays := backoff.New(c.params.MinBackoff, c.params.MaxBackoff, 0.5)
+
+	for {
+		connected, err := c.session(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if errors.Is(err, ErrReconnectNotAllowed) {
+			return err
+		}
+
+		c.report(err)
+
+		// Start over from the shortest delay once a connection succeeded
+		if connected {
+			delays.Reset()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delays.Delay()):
+		}
+
+		delays.Increase()
+	}
+}
+
+// session runs a single connection until it fails.
+// It reports whether the handshake completed, and always returns a non-nil error.
+func (c *Client) session(ctx context.Context) (bool, error) {
+	conn, err := c.connect(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() { _ = conn.CloseNow() }()
+
+	if err := c.send(ctx, ConnectedEvent{}); err != nil {
+		return true, err
+	}
+
+	// Send pings until the connection is closed
+	sessionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go c.keepAlive(sessionCtx, conn)
+
+	err = c.readLoop(sessionCtx, conn)
+	if ctx.Err() != nil {
+		return true, ctx.Err()
+	}
+
+	_ = c.send(ctx, DisconnectedEvent{Err: err})
+
+	return true, err
+}
+
+// connect dials the hub with the next cookie and performs the handshake.
+func (c *Client) connect(ctx context.Context) (*websocket.Conn, error) {
+	cookie, err := c.cookies.NextCookie()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.params.HandshakeTimeout)
+	defer cancel()
+
+	header := http.Header{}
+	header.Set("Cookie", ".ROBLOSECURITY="+cookie)
+
+	conn, resp, err := websocket.Dial(ctx, c.params.URL, &websocket.DialOptions{
+		HTTPClient: c.params.HTTPClient,
+		HTTPHeader: header,
+	})
+	if resp != nil && resp.Body != nil {
+		_ = resp.Body.Close()
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", c.params.URL, err)
+	}
+
+	conn.SetReadLimit(maxMessageSize)
+
+	if err := conn.Write(ctx, websocket.MessageText, handshakeRequest); err != nil {
+		_ = conn.CloseNow()
+		return nil, fmt.Errorf("%w: %w", ErrHandshakeFailed, err)
+	}
+
+	_, data, err := conn.Read(ctx)
+	if err != nil {
+		_ = conn.CloseNow()
+		return nil, fmt.Errorf("%w: %w", ErrHandshakeFailed, err)
+	}
+
+	records := splitRecords(data)
+	if len(records) == 0 {
+		_ = conn.CloseNow()
+		return nil, fmt.Errorf("%w: empty response", ErrHandshakeFailed)
+	}
+
+	var handshake handshakeResponse
+	if err := json.Unmarshal(records[0], &handshake); err != nil {
+		_ = conn.CloseNow()
+		return nil, fmt.Errorf("%w: %w", ErrHandshakeFailed, err)
+	}
+
+	if handshake.Error != "" {
+		_ = conn.CloseNow()
+		return nil, fmt.Errorf("%w: %s", ErrHandshakeFailed, handshake.Error)
+	}
+
+	return conn, nil
+}
+
+// keepAlive sends a ping every KeepAlive interval until the context is done.
+func (c *Client) keepAlive(ctx context.Context, conn *websocket.Conn) {
+	ticker := time.NewTicker(c.params.KeepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := conn.Write(ctx, websocket.MessageText, pingMessage); err != nil {
+				// The read loop notices the broken connection
+				return
+			}
+		}
+	}
+}
+
+// readLoop handles incoming messages until the connection fails or the server
+// stays silent for longer than ServerTimeout.
+func (c *Client) readLoop(ctx context.Context, conn *websocket.Conn) error {
+	for {
+		readCtx, cancel := context.WithTimeout(ctx, c.params.ServerTimeout)
+		messageType, data, err := conn.Read(readCtx)
+		timedOut := errors.Is(readCtx.Err(), context.DeadlineExceeded)
+
+		cancel()
+
+		if err != nil {
+			if timedOut && ctx.Err() == nil {
+				return ErrServerTimeout
+			}
+
+			return err
+		}
+
+		if messageType != websocket.MessageText {
+			return fmt.Errorf("%w: %s", ErrUnexpectedMessageType, messageType)
+		}
+
+		for _, record := range splitRecords(data) {
+			if err := c.handleRecord(ctx, record); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// handleRecord handles a single hub message.
+func (c *Client) handleRecord(ctx context.Context, record []byte) error {
+	var message hubMessage
+	if err := json.Unmarshal(record, &message); err != nil {
+		c.report(fmt.Errorf("failed to decode hub message: %w", err))
+		return nil
+	}
+
+	switch message.Type {
+	case messageTypeInvocation:
+		if message.Target != notificationTarget {
+			return nil
+		}
+
+		notification, err := parseNotification(message.Arguments)
+		if err != nil {
+			c.report(err)
+			return nil
+		}
+
+		events, err := decodeNotification(notification)
+		if err != nil {
+			c.report(err)
+		}
+
+		for _, event := range events {
+			if err := c.send(ctx, event); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case messageTypeClose:
+		err := ErrServerClosed
+		if message.Error != "" {
+			err = fmt.Errorf("%w: %s", ErrServerClosed, message.Error)
+		}
+
+		if !message.AllowReconnect {
+			return fmt.Errorf("%w: %w", ErrReconnectNotAllowed, err)
+		}
+
+		return err
+
+	default:
+		// Pings and other messages only show the connection is alive
+		return nil
+	}
+}
+
+// parseNotification reads the namespace, detail and sequence number of a notification.
+func parseNotification(arguments []json.RawMessage) (Notification, error) {
+	var notification Notification
+
+	if len(arguments) < 2 {
+		return notification, fmt.Errorf("%w: notification has %d arguments", errs.ErrInvalidResponse, len(arguments))
+	}
+
+	if err := json.Unmarshal(arguments[0], &notification.Namespace); err != nil {
+		return notification, fmt.Errorf("%w: namespace: %w", errs.ErrInvalidResponse, err)
+	}
+
+	// The detail is a JSON document encoded as a string
+	var detail string
+	if err := json.Unmarshal(arguments[1], &detail); err != nil {
+		return notification, fmt.Errorf("%w: detail: %w", errs.ErrInvalidResponse, err)
+	}
+
+	notification.Detail = json.RawMessage(detail)
+
+	if len(arguments) > 2 {
+		if err := json.Unmarshal(arguments[2], &notification.Sequence); err != nil {
+			return notification, fmt.Errorf("%w: sequence: %w", errs.ErrInvalidResponse, err)
+		}
+	}
+
+	return notification, nil
+}
+
+// send delivers an event, waiting for room on the channel.
+func (c *Client) send(ctx context.Context, event Event) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case c.events <- event:
+		return nil
+	}
+}
+
+// report delivers an error if there is room on the channel.
+func (c *Client) report(err error) {
+	select {
+	case c.errors <- err:
+	default:
+	}
+}
+
+// ClientParams holds the parameters for connecting to the realtime hub.
+type ClientParams struct {
+	URL              string        `json:"url"              validate:"required,url"`
+	KeepAlive        time.Duration `json:"keepAlive"        validate:"gt=0"`
+	ServerTimeout    time.Duration `json:"serverTimeout"    validate:"gtfield=KeepAlive"`
+	HandshakeTimeout time.Duration `json:"handshakeTimeout" validate:"gt=0"`
+	MinBackoff       time.Duration `json:"minBackoff"       validate:"gt=0"`
+	MaxBackoff       time.Duration `json:"maxBackoff"       validate:"gtefield=MinBackoff"`
+	BufferSize       int           `json:"bufferSize"       validate:"min=0"`
+	HTTPClient       *http.Client  `json:"-"`
+}
+
+// ClientBuilder is a builder for ClientParams.
+type ClientBuilder struct {
+	params ClientParams
+}
+
+// NewClientBuilder creates a new ClientBuilder with default values.
+func NewClientBuilder() *ClientBuilder {
+	return &ClientBuilder{
+		params: ClientParams{
+			URL:              types.RealtimeEndpoint,
+			KeepAlive:        15 * time.Second,
+			ServerTimeout:    30 * time.Second,
+			HandshakeTimeout: 10 * time.Second,
+			MinBackoff:       time.Second,
+			MaxBackoff:       time.Minute,
+			BufferSize:       100,
+			HTTPClient:       nil,
+		},
+	}
+}
+
+// WithURL sets the websocket URL of the hub.
+func (b *ClientBuilder) WithURL(url string) *ClientBuilder {
+	b.params.URL = url
+	return b
+}
+
+// WithKeepAlive sets how often pings are sent to the hub.
+func (b *ClientBuilder) WithKeepAlive(keepAlive time.Duration) *ClientBuilder {
+	b.params.KeepAlive = keepAlive
+	return b
+}
+
+// WithServerTimeout sets how long the hub may stay silent before the connection is dropped.
+func (b *ClientBuilder) WithServerTimeout(serverTimeout time.Duration) *ClientBuilder {
+	b.params.ServerTimeout = serverTimeout
+	return b
+}
+
+// WithHandshakeTimeout sets how long connecting and the handshake may take.
+func (b *ClientBuilder) WithHandshakeTimeout(handshakeTimeout time.Duration) *ClientBuilder {
+	b.params.HandshakeTimeout = handshakeTimeout
+	return b
+}
+
+// WithBackoff sets the shortest and longest delay between reconnect attempts.
+func (b *ClientBuilder) WithBackoff(minBackoff, maxBackoff time.Duration) *ClientBuilder {
+	b.params.MinBackoff = minBackoff
+	b.params.MaxBackoff = maxBackoff
+
+	return b
+}
+
+// WithBufferSize sets the capacity of the event and error channels.
+func (b *ClientBuilder) WithBufferSize(bufferSize int) *ClientBuilder {
+	b.params.BufferSize = bufferSize
+	return b
+}
+
+// WithHTTPClient sets the HTTP client used to dial the hub, such as one with a proxy transport.
+// The client must not set a Timeout; use WithHandshakeTimeout instead.
+func (b *ClientBuilder) WithHTTPClient(httpClient *http.Client) *ClientBuilder {
+	b.params.HTTPClient = httpClient
+	return b
+}
+
+// Build returns the ClientParams.
+func (b *ClientBuilder) Build() ClientParams {
+	return b.params
+}
@@ -0,0 +1,246 @@
+package realtime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/realtime"
+	"github.com/jaxron/roapi.go/pkg/api/realtime/realtimetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nextEvent waits for the next event of type T, skipping other events.
+func nextEvent[T realtime.Event](ctx context.Context, t *testing.T, client *realtime.Client) T {
+	t.Helper()
+
+	for {
+		select {
+		case <-ctx.Done():
+			var zero T
+			t.Fatalf("no %T received", zero)
+
+			return zero
+		case event, ok := <-client.Events():
+			require.True(t, ok, "event channel closed")
+
+			if typed, ok := event.(T); ok {
+				return typed
+			}
+		}
+	}
+}
+
+func TestClient(t *testing.T) {
+	v := validator.New(validator.WithRequiredStructEnabled())
+
+	newClient := func(t *testing.T, server *realtimetest.Server, cookies ...string) *realtime.Client {
+		t.Helper()
+
+		params := realtime.NewClientBuilder().
+			WithURL(server.URL).
+			WithKeepAlive(10*time.Millisecond).
+			WithServerTimeout(time.Second).
+			WithBackoff(time.Millisecond, 10*time.Millisecond).
+			Build()
+
+		client, err := realtime.NewClient(auth.New(cookies), v, params)
+		require.NoError(t, err)
+
+		return client
+	}
+
+	t.Run("Decode Typed Events", func(t *testing.T) {
+		server := realtimetest.NewServer()
+		defer server.Close()
+
+		client := newClient(t, server, "cookie")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		go func() { _ = client.Run(ctx) }()
+
+		nextEvent[realtime.ConnectedEvent](ctx, t, client)
+		assert.Equal(t, []string{"cookie"}, server.Cookies())
+
+		require.NoError(t, server.Notify(ctx, realtime.NamespaceFriendship, map[string]any{
+			"Type":      "FriendshipCreated",
+			"EventArgs": map[string]any{"UserId1": 1, "UserId2": 2},
+		}))
+
+		friendship := nextEvent[realtime.FriendshipEvent](ctx, t, client)
+		assert.Equal(t, "FriendshipCreated", friendship.Type)
+		assert.Equal(t, int64(1), friendship.UserID1)
+		assert.Equal(t, int64(2), friendship.UserID2)
+		assert.Equal(t, int64(1), friendship.Sequence)
+
+		require.NoError(t, server.Notify(ctx, realtime.NamespacePresence, []map[string]any{
+			{"Type": "PresenceChanged", "UserId": 3},
+			{"Type": "PresenceChanged", "UserId": 4},
+		}))
+
+		assert.Equal(t, int64(3), nextEvent[realtime.PresenceEvent](ctx, t, client).UserID)
+		assert.Equal(t, int64(4), nextEvent[realtime.PresenceEvent](ctx, t, client).UserID)
+
+		require.NoError(t, server.Notify(ctx, realtime.NamespaceChat, map[string]any{
+			"Type":           "NewMessage",
+			"ConversationId": 123456789012,
+		}))
+
+		chat := nextEvent[realtime.ChatEvent](ctx, t, client)
+		assert.Equal(t, "NewMessage", chat.Type)
+		assert.Equal(t, "123456789012", chat.ConversationID)
+
+		require.NoError(t, server.Notify(ctx, realtime.NamespaceAuthentication, map[string]any{"Type": "SignOut"}))
+		assert.Equal(t, "SignOut", nextEvent[realtime.AuthenticationEvent](ctx, t, client).Type)
+
+		require.NoError(t, server.Notify(ctx, "GameFavoriteNotifications", map[string]any{"Type": "Favorited"}))
+
+		unknown := nextEvent[realtime.UnknownEvent](ctx, t, client)
+		assert.Equal(t, "GameFavoriteNotifications", unknown.Namespace)
+		assert.JSONEq(t, `{"Type":"Favorited"}`, string(unknown.Detail))
+	})
+
+	t.Run("Report Undecodable Notifications", func(t *testing.T) {
+		server := realtimetest.NewServer()
+		defer server.Close()
+
+		client := newClient(t, server, "cookie")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		go func() { _ = client.Run(ctx) }()
+
+		nextEvent[realtime.ConnectedEvent](ctx, t, client)
+		require.NoError(t, server.Notify(ctx, realtime.NamespacePresence, map[string]any{"Type": "PresenceChanged"}))
+
+		unknown := nextEvent[realtime.UnknownEvent](ctx, t, client)
+		assert.Equal(t, realtime.NamespacePresence, unknown.Namespace)
+		require.Error(t, <-client.Errors())
+	})
+
+	t.Run("Send Keepalive Pings", func(t *testing.T) {
+		server := realtimetest.NewServer()
+		defer server.Close()
+
+		client := newClient(t, server, "cookie")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		go func() { _ = client.Run(ctx) }()
+
+		nextEvent[realtime.ConnectedEvent](ctx, t, client)
+		assert.Eventually(t, func() bool { return server.Pings() >= 3 }, 2*time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("Reconnect With Next Cookie", func(t *testing.T) {
+		server := realtimetest.NewServer()
+		defer server.Close()
+
+		client := newClient(t, server, "cookie1", "cookie2")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		go func() { _ = client.Run(ctx) }()
+
+		nextEvent[realtime.ConnectedEvent](ctx, t, client)
+		server.Disconnect()
+
+		disconnected := nextEvent[realtime.DisconnectedEvent](ctx, t, client)
+		require.Error(t, disconnected.Err)
+
+		nextEvent[realtime.ConnectedEvent](ctx, t, client)
+		assert.Equal(t, 2, server.Connects())
+		assert.Equal(t, []string{"cookie1", "cookie2"}, server.Cookies())
+	})
+
+	t.Run("Retry Rejected Connections", func(t *testing.T) {
+		server := realtimetest.NewServer(realtimetest.WithCookies("valid"))
+		defer server.Close()
+
+		client := newClient(t, server, "invalid", "valid")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		go func() { _ = client.Run(ctx) }()
+
+		nextEvent[realtime.ConnectedEvent](ctx, t, client)
+		require.Error(t, <-client.Errors())
+		assert.Equal(t, []string{"invalid", "valid"}, server.Cookies())
+	})
+
+	t.Run("Time Out Silent Server", func(t *testing.T) {
+		server := realtimetest.NewServer(realtimetest.WithPingInterval(0))
+		defer server.Close()
+
+		params := realtime.NewClientBuilder().
+			WithURL(server.URL).
+			WithKeepAlive(10*time.Millisecond).
+			WithServerTimeout(50*time.Millisecond).
+			WithBackoff(time.Millisecond, 10*time.Millisecond).
+			Build()
+
+		client, err := realtime.NewClient(auth.New([]string{"cookie"}), v, params)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		go func() { _ = client.Run(ctx) }()
+
+		disconnected := nextEvent[realtime.DisconnectedEvent](ctx, t, client)
+		require.ErrorIs(t, disconnected.Err, realtime.ErrServerTimeout)
+	})
+
+	t.Run("Stop When Reconnect Is Not Allowed", func(t *testing.T) {
+		server := realtimetest.NewServer()
+		defer server.Close()
+
+		client := newClient(t, server, "cookie")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		done := make(chan error, 1)
+		go func() { done <- client.Run(ctx) }()
+
+		nextEvent[realtime.ConnectedEvent](ctx, t, client)
+		require.NoError(t, server.CloseConnections(ctx, "Server is shutting down", false))
+
+		// Drain events so Run is never blocked on a full channel
+		go func() {
+			for range client.Events() { //nolint:revive // Discard remaining events
+			}
+		}()
+
+		select {
+		case err := <-done:
+			require.ErrorIs(t, err, realtime.ErrReconnectNotAllowed)
+			assert.Contains(t, err.Error(), "Server is shutting down")
+		case <-ctx.Done():
+			t.Fatal("Run did not return")
+		}
+
+		assert.Equal(t, 1, server.Connects())
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		params := realtime.NewClientBuilder().WithKeepAlive(time.Minute).WithServerTimeout(time.Second).Build()
+		_, err := realtime.NewClient(auth.New(nil), v, params)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ServerTimeout")
+
+		params = realtime.NewClientBuilder().WithURL("").Build()
+		_, err = realtime.NewClient(auth.New(nil), v, params)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "URL")
+	})
+}
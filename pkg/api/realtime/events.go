@@ -0,0 +1,174 @@
+package realtime
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Notification namespaces decoded into typed events.
+const (
+	NamespaceFriendship     = "FriendshipNotifications"
+	NamespacePresence       = "PresenceBulkNotifications"
+	NamespaceChat           = "ChatNotifications"
+	NamespaceAuthentication = "AuthenticationNotifications"
+)
+
+// Event is a value sent on the client's event channel.
+// It is one of ConnectedEvent, DisconnectedEvent, FriendshipEvent, PresenceEvent,
+// ChatEvent, AuthenticationEvent or UnknownEvent.
+type Event interface {
+	event()
+}
+
+// Notification holds the fields shared by every notification event.
+type Notification struct {
+	Namespace string          // Namespace the notification was published in
+	Sequence  int64           // Sequence number of the notification within the connection
+	Detail    json.RawMessage // Raw detail payload, for fields the typed event does not expose
+}
+
+// ConnectedEvent is sent once a connection has completed the handshake.
+// Notifications published while disconnected are lost, so this is the point to resync state.
+type ConnectedEvent struct{}
+
+// DisconnectedEvent is sent when an established connection is lost.
+type DisconnectedEvent struct {
+	Err error // Reason the connection was lost
+}
+
+// FriendshipEvent is sent when a friendship of the authenticated user changes.
+type FriendshipEvent struct {
+	Notification
+	Type    string // Kind of change (e.g., "FriendshipCreated", "FriendshipDestroyed", "FriendshipRequested")
+	UserID1 int64  // ID of the user that triggered the change
+	UserID2 int64  // ID of the other user
+}
+
+// PresenceEvent is sent when the presence of a friend of the authenticated user changes.
+// Only the user is reported; fetch the new presence with the presence resource.
+type PresenceEvent struct {
+	Notification
+	Type   string // Kind of change (e.g., "PresenceChanged")
+	UserID int64  // ID of the user whose presence changed
+}
+
+// ChatEvent is sent when a conversation of the authenticated user changes.
+type ChatEvent struct {
+	Notification
+	Type           string // Kind of change (e.g., "NewMessage", "MessageMarkedAsRead")
+	ConversationID string // ID of the conversation that changed
+}
+
+// AuthenticationEvent is sent when the session of the authenticated user changes.
+// A "SignOut" event means the cookie the client connected with is no longer valid.
+type AuthenticationEvent struct {
+	Notification
+	Type string // Kind of change (e.g., "SignOut")
+}
+
+// UnknownEvent is sent for notifications in namespaces without a typed event,
+// and for notifications whose detail could not be decoded.
+type UnknownEvent struct {
+	Notification
+}
+
+func (ConnectedEvent) event()      {}
+func (DisconnectedEvent) event()   {}
+func (FriendshipEvent) event()     {}
+func (PresenceEvent) event()       {}
+func (ChatEvent) event()           {}
+func (AuthenticationEvent) event() {}
+func (UnknownEvent) event()        {}
+
+// friendshipDetail is the detail payload of a friendship notification.
+type friendshipDetail struct {
+	Type      string `json:"Type"`
+	EventArgs struct {
+		UserID1 int64 `json:"UserId1"`
+		UserID2 int64 `json:"UserId2"`
+	} `json:"EventArgs"`
+}
+
+// presenceDetail is a single entry of a bulk presence notification.
+type presenceDetail struct {
+	Type   string `json:"Type"`
+	UserID int64  `json:"UserId"`
+}
+
+// chatDetail is the detail payload of a chat notification.
+type chatDetail struct {
+	Type           string          `json:"Type"`
+	ConversationID json.RawMessage `json:"ConversationId"`
+}
+
+// typeDetail is the detail payload of notifications that only carry a type.
+type typeDetail struct {
+	Type string `json:"Type"`
+}
+
+// decodeNotification turns a notification into typed events.
+// If the detail cannot be decoded, an UnknownEvent is returned along with the error.
+func decodeNotification(n Notification) ([]Event, error) {
+	events, err := decodeDetail(n)
+	if err != nil {
+		return []Event{UnknownEvent{Notification: n}}, fmt.Errorf("failed to decode %s notification: %w", n.Namespace, err)
+	}
+
+	return events, nil
+}
+
+// decodeDetail decodes the detail payload of a notification based on its namespace.
+func decodeDetail(n Notification) ([]Event, error) {
+	switch n.Namespace {
+	case NamespaceFriendship:
+		var detail friendshipDetail
+		if err := json.Unmarshal(n.Detail, &detail); err != nil {
+			return nil, err
+		}
+
+		return []Event{FriendshipEvent{
+			Notification: n,
+			Type:         detail.Type,
+			UserID1:      detail.EventArgs.UserID1,
+			UserID2:      detail.EventArgs.UserID2,
+		}}, nil
+
+	case NamespacePresence:
+		var details []presenceDetail
+		if err := json.Unmarshal(n.Detail, &details); err != nil {
+			return nil, err
+		}
+
+		events := make([]Event, 0, len(details))
+		for _, detail := range details {
+			events = append(events, PresenceEvent{Notification: n, Type: detail.Type, UserID: detail.UserID})
+		}
+
+		return events, nil
+
+	case NamespaceChat:
+		var detail chatDetail
+		if err := json.Unmarshal(n.Detail, &detail); err != nil {
+			return nil, err
+		}
+
+		// Conversation IDs have been sent both as numbers and as strings
+		conversationID := ""
+		if err := json.Unmarshal(detail.ConversationID, &conversationID); err != nil {
+			conversationID = string(detail.ConversationID)
+		}
+
+		return []Event{ChatEvent{Notification: n, Type: detail.Type, ConversationID: conversationID}}, nil
+
+	case NamespaceAuthentication:
+		var detail typeDetail
+		if err := json.Unmarshal(n.Detail, &detail); err != nil {
+			return nil, err
+		}
+
+		return []Event{AuthenticationEvent{Notification: n, Type: detail.Type}}, nil
+
+	default:
+		return []Event{UnknownEvent{Notification: n}}, nil
+	}
+}
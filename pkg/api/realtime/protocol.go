@@ -0,0 +1,50 @@
+package realtime
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// recordSeparator terminates every message of the hub's JSON protocol.
+const recordSeparator = 0x1e
+
+// Hub message types used by the client.
+const (
+	messageTypeInvocation = 1
+	messageTypeClose      = 7
+)
+
+// notificationTarget is the hub method that carries notifications.
+const notificationTarget = "notification"
+
+// handshakeRequest selects the JSON protocol when the connection is opened.
+var handshakeRequest = append([]byte(`{"protocol":"json","version":1}`), recordSeparator)
+
+// pingMessage keeps the connection alive.
+var pingMessage = append([]byte(`{"type":6}`), recordSeparator)
+
+// handshakeResponse is the hub's reply to the handshake request.
+type handshakeResponse struct {
+	Error string `json:"error"`
+}
+
+// hubMessage is a single message received from the hub.
+type hubMessage struct {
+	Type           int               `json:"type"`
+	Target         string            `json:"target"`
+	Arguments      []json.RawMessage `json:"arguments"`
+	Error          string            `json:"error"`
+	AllowReconnect bool              `json:"allowReconnect"`
+}
+
+// splitRecords splits a websocket frame into the records it contains.
+func splitRecords(data []byte) [][]byte {
+	records := bytes.Split(data, []byte{recordSeparator})
+
+	// Drop the empty record after the final separator
+	if len(records) > 0 && len(records[len(records)-1]) == 0 {
+		records = records[:len(records)-1]
+	}
+
+	return records
+}
@@ -0,0 +1,347 @@
+// Package realtimetest provides a local stand-in for the realtime notification hub,
+// so that code using the realtime package can be tested offline.
+package realtimetest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+// recordSeparator terminates every message of the hub's JSON protocol.
+const recordSeparator = 0x1e
+
+var (
+	ErrNoConnections     = errors.New("no clients connected")
+	ErrHandshakeRejected = errors.New("handshake rejected")
+)
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithCookies only accepts connections authenticated with one of the given cookies.
+// Other connections are rejected with 401 Unauthorized. By default any cookie is accepted.
+func WithCookies(cookies ...string) Option {
+	return func(s *Server) {
+		s.cookies = cookies
+	}
+}
+
+// WithPingInterval sets how often the server pings its clients. The default of 15 seconds
+// matches the hub; zero disables pings so that client timeouts can be tested.
+func WithPingInterval(interval time.Duration) Option {
+	return func(s *Server) {
+		s.pingInterval = interval
+	}
+}
+
+// Server is a local websocket hub speaking the same handshake and JSON protocol as the
+// realtime notification hub. Use URL as the client's hub URL.
+type Server struct {
+	URL string // Websocket URL of the server
+
+	server       *httptest.Server
+	cookies      []string
+	pingInterval time.Duration
+
+	mu       sync.Mutex
+	conns    map[*websocket.Conn]struct{}
+	seen     []string
+	connects int
+	pings    int
+	sequence int64
+}
+
+// NewServer starts a new Server. Callers should call Close when finished.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		pingInterval: 15 * time.Second,
+		conns:        make(map[*websocket.Conn]struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	s.URL = "ws" + strings.TrimPrefix(s.server.URL, "http")
+
+	return s
+}
+
+// Close disconnects every client and shuts the server down.
+func (s *Server) Close() {
+	s.Disconnect()
+	s.server.Close()
+}
+
+// Notify publishes a notification to every connected client.
+// The detail is encoded as JSON, and each notification gets the next sequence number.
+func (s *Server) Notify(ctx context.Context, namespace string, detail any) error {
+	encoded, err := json.Marshal(detail)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.sequence++
+	sequence := s.sequence
+	conns := s.connections()
+	s.mu.Unlock()
+
+	if len(conns) == 0 {
+		return ErrNoConnections
+	}
+
+	message, err := encode(map[string]any{
+		"type":      1,
+		"target":    "notification",
+		"arguments": []any{namespace, string(encoded), sequence},
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.broadcast(ctx, conns, message)
+}
+
+// CloseConnections sends a close message to every connected client, as the hub does
+// when it shuts down. allowReconnect tells the clients whether they may reconnect.
+func (s *Server) CloseConnections(ctx context.Context, reason string, allowReconnect bool) error {
+	s.mu.Lock()
+	conns := s.connections()
+	s.mu.Unlock()
+
+	message, err := encode(map[string]any{
+		"type":           7,
+		"error":          reason,
+		"allowReconnect": allowReconnect,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.broadcast(ctx, conns, message)
+}
+
+// Disconnect drops every connection without a close message, as a network failure would.
+func (s *Server) Disconnect() {
+	s.mu.Lock()
+	conns := s.connections()
+	s.mu.Unlock()
+
+	for _, conn := range conns {
+		_ = conn.CloseNow()
+	}
+}
+
+// WaitForConnects blocks until at least n handshakes have completed or the context is done.
+func (s *Server) WaitForConnects(ctx context.Context, n int) error {
+	ticker := time.NewTicker(5 * time.Millisecond)
+	defer ticker.Stop()
+
+	for s.Connects() < n {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	return nil
+}
+
+// Connects returns the number of handshakes completed so far.
+func (s *Server) Connects() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.connects
+}
+
+// Pings returns the number of pings received from clients so far.
+func (s *Server) Pings() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.pings
+}
+
+// Cookies returns the cookies of every connection attempt, in order.
+func (s *Server) Cookies() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.seen)
+}
+
+// handle accepts a websocket connection and serves it until it is closed.
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	cookie := ""
+	if c, err := r.Cookie(".ROBLOSECURITY"); err == nil {
+		cookie = c.Value
+	}
+
+	s.mu.Lock()
+	s.seen = append(s.seen, cookie)
+	s.mu.Unlock()
+
+	if len(s.cookies) > 0 && !slices.Contains(s.cookies, cookie) {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	conn, err := websocket.Accept(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	defer func() { _ = conn.CloseNow() }()
+
+	ctx := r.Context()
+
+	if err := s.handshake(ctx, conn); err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	s.conns[conn] = struct{}{}
+	s.connects++
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+	}()
+
+	if s.pingInterval > 0 {
+		go s.ping(ctx, conn)
+	}
+
+	s.read(ctx, conn)
+}
+
+// handshake reads the client's protocol selection and answers it.
+func (s *Server) handshake(ctx context.Context, conn *websocket.Conn) error {
+	_, data, err := conn.Read(ctx)
+	if err != nil {
+		return err
+	}
+
+	var request struct {
+		Protocol string `json:"protocol"`
+		Version  int    `json:"version"`
+	}
+
+	reason := ""
+	if err := json.Unmarshal(bytes.TrimSuffix(data, []byte{recordSeparator}), &request); err != nil {
+		reason = "invalid handshake request"
+	} else if request.Protocol != "json" || request.Version != 1 {
+		reason = "unsupported protocol " + request.Protocol
+	}
+
+	response := map[string]any{}
+	if reason != "" {
+		response["error"] = reason
+	}
+
+	message, err := encode(response)
+	if err != nil {
+		return err
+	}
+
+	if err := conn.Write(ctx, websocket.MessageText, message); err != nil {
+		return err
+	}
+
+	if reason != "" {
+		return fmt.Errorf("%w: %s", ErrHandshakeRejected, reason)
+	}
+
+	return nil
+}
+
+// read counts the client's pings until the connection is closed.
+func (s *Server) read(ctx context.Context, conn *websocket.Conn) {
+	for {
+		_, data, err := conn.Read(ctx)
+		if err != nil {
+			return
+		}
+
+		for record := range bytes.SplitSeq(data, []byte{recordSeparator}) {
+			var message struct {
+				Type int `json:"type"`
+			}
+
+			if json.Unmarshal(record, &message) == nil && message.Type == 6 {
+				s.mu.Lock()
+				s.pings++
+				s.mu.Unlock()
+			}
+		}
+	}
+}
+
+// ping sends a ping every ping interval until the connection is closed.
+func (s *Server) ping(ctx context.Context, conn *websocket.Conn) {
+	ticker := time.NewTicker(s.pingInterval)
+	defer ticker.Stop()
+
+	message := append([]byte(`{"type":6}`), recordSeparator)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := conn.Write(ctx, websocket.MessageText, message); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// connections returns the open connections. The caller must hold the lock.
+func (s *Server) connections() []*websocket.Conn {
+	conns := make([]*websocket.Conn, 0, len(s.conns))
+	for conn := range s.conns {
+		conns = append(conns, conn)
+	}
+
+	return conns
+}
+
+// broadcast writes a message to the given connections.
+func (s *Server) broadcast(ctx context.Context, conns []*websocket.Conn, message []byte) error {
+	var errs []error
+
+	for _, conn := range conns {
+		if err := conn.Write(ctx, websocket.MessageText, message); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// encode marshals a hub message and terminates it with the record separator.
+func encode(message map[string]any) ([]byte, error) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, recordSeparator), nil
+}
@@ -0,0 +1,327 @@
+// Package authtest provides a local stand-in for the login and two-step verification
+// endpoints, so that the login flow can be tested offline.
+package authtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jaxron/axonet/pkg/client/logger"
+	"github.com/jaxron/axonet/pkg/client/middleware"
+	"github.com/jaxron/roapi.go/pkg/api/resources/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// csrfToken is the token the server hands out and expects on every request.
+const csrfToken = "authtest-csrf-token"
+
+// Account is an account the server can log in.
+type Account struct {
+	ID         int64                  // ID of the user
+	Username   string                 // Username to log in with
+	Email      string                 // Email address to log in with
+	Password   string                 // Password to log in with
+	Cookie     string                 // Cookie set on a successful login
+	MediaType  types.TwoStepMediaType // Two-step verification method, empty to disable it
+	TOTPSecret string                 // Base32 secret for authenticator challenges
+	EmailCode  string                 // Code accepted for email challenges
+	IsBanned   bool                   // Whether the account is banned
+}
+
+// Server is a local HTTP server implementing the login and two-step verification endpoints.
+// Route a client to it with Middleware.
+type Server struct {
+	server *httptest.Server
+
+	mu       sync.Mutex
+	accounts []*Account
+	tickets  map[string]*Account
+	sent     map[string]bool
+	verified map[string]string
+	requests []string
+}
+
+// NewServer starts a new Server with the given accounts. Callers should call Close when finished.
+func NewServer(accounts ...Account) *Server {
+	s := &Server{
+		tickets:  make(map[string]*Account),
+		sent:     make(map[string]bool),
+		verified: make(map[string]string),
+	}
+
+	for _, account := range accounts {
+		s.accounts = append(s.accounts, &account)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v2/login", s.handleLogin)
+	mux.HandleFunc("POST /v1/users/{userId}/challenges/email/send-code", s.handleSendCode)
+	mux.HandleFunc("POST /v1/users/{userId}/challenges/{mediaType}/verify", s.handleVerify)
+	mux.HandleFunc("POST /v3/users/{userId}/two-step-verification/login", s.handleTwoStepLogin)
+
+	s.server = httptest.NewServer(s.checkCSRF(mux))
+
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Middleware returns client middleware that sends every request to the server instead of
+// its original host. Add it with client.WithMiddleware.
+func (s *Server) Middleware() middleware.Middleware {
+	target, _ := url.Parse(s.server.URL)
+	return &redirect{target: target}
+}
+
+// Requests returns the paths of the requests received so far, in order.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.requests)
+}
+
+// checkCSRF rejects requests without the CSRF token, handing out the token like the real endpoints.
+func (s *Server) checkCSRF(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, r.URL.Path)
+		s.mu.Unlock()
+
+		if r.Header.Get("X-Csrf-Token") != csrfToken {
+			w.Header().Set("X-Csrf-Token", csrfToken)
+			writeError(w, http.StatusForbidden, 0, "Token Validation Failed")
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handleLogin checks the credentials and either sets the cookie or starts two-step verification.
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		CredentialType  string `json:"ctype"`
+		CredentialValue string `json:"cvalue"`
+		Password        string `json:"password"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, 0, "Invalid request")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	index := slices.IndexFunc(s.accounts, func(account *Account) bool {
+		switch types.CredentialType(body.CredentialType) {
+		case types.CredentialTypeUsername:
+			return account.Username == body.CredentialValue
+		case types.CredentialTypeEmail:
+			return account.Email != "" && account.Email == body.CredentialValue
+		default:
+			return false
+		}
+	})
+	if index < 0 || s.accounts[index].Password != body.Password {
+		writeError(w, http.StatusForbidden, 1, "Incorrect username or password. Please try again.")
+		return
+	}
+
+	account := s.accounts[index]
+	response := map[string]any{
+		"user": map[string]any{
+			"id":          account.ID,
+			"name":        account.Username,
+			"displayName": account.Username,
+		},
+		"isBanned": account.IsBanned,
+	}
+
+	if account.MediaType == "" {
+		setCookie(w, account.Cookie)
+		writeJSON(w, response)
+
+		return
+	}
+
+	ticket := fmt.Sprintf("ticket-%d-%d", account.ID, len(s.tickets)+1)
+	s.tickets[ticket] = account
+
+	response["twoStepVerificationData"] = map[string]any{
+		"mediaType": account.MediaType,
+		"ticket":    ticket,
+	}
+
+	writeJSON(w, response)
+}
+
+// handleSendCode records that an email code was requested for a ticket.
+func (s *Server) handleSendCode(w http.ResponseWriter, r *http.Request) {
+	challenge, account, ok := s.readChallenge(w, r)
+	if !ok {
+		return
+	}
+
+	if account.MediaType != types.TwoStepMediaTypeEmail {
+		writeError(w, http.StatusBadRequest, 5, "Invalid challenge.")
+		return
+	}
+
+	s.mu.Lock()
+	s.sent[challenge.ChallengeID] = true
+	s.mu.Unlock()
+
+	writeJSON(w, map[string]any{})
+}
+
+// handleVerify checks a two-step verification code and hands out a verification token.
+func (s *Server) handleVerify(w http.ResponseWriter, r *http.Request) {
+	challenge, account, ok := s.readChallenge(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ticket := challenge.ChallengeID
+	valid := false
+
+	switch {
+	case r.PathValue("mediaType") == "authenticator" && account.MediaType == types.TwoStepMediaTypeAuthenticator:
+		// Accept the previous code as well to allow for clock drift
+		now := time.Now()
+		for _, t := range []time.Time{now, now.Add(-30 * time.Second)} {
+			if code, err := auth.GenerateTOTP(account.TOTPSecret, t); err == nil && code == challenge.Code {
+				valid = true
+			}
+		}
+	case r.PathValue("mediaType") == "email" && account.MediaType == types.TwoStepMediaTypeEmail:
+		valid = s.sent[ticket] && account.EmailCode == challenge.Code
+	}
+
+	if !valid {
+		writeError(w, http.StatusBadRequest, 10, "The code is invalid.")
+		return
+	}
+
+	token := "verified-" + ticket
+	s.verified[ticket] = token
+
+	writeJSON(w, map[string]any{"verificationToken": token})
+}
+
+// handleTwoStepLogin checks the verification token and sets the cookie.
+func (s *Server) handleTwoStepLogin(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		ChallengeID       string `json:"challengeId"`
+		VerificationToken string `json:"verificationToken"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, 0, "Invalid request")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	account, found := s.tickets[body.ChallengeID]
+	token, verified := s.verified[body.ChallengeID]
+
+	if !found || !verified || token != body.VerificationToken || strconv.FormatInt(account.ID, 10) != r.PathValue("userId") {
+		writeError(w, http.StatusBadRequest, 1, "Invalid two step verification ticket.")
+		return
+	}
+
+	// Tickets can only be used once
+	delete(s.tickets, body.ChallengeID)
+
+	setCookie(w, account.Cookie)
+	writeJSON(w, map[string]any{})
+}
+
+// challengeRequest is the body of a two-step verification challenge request.
+type challengeRequest struct {
+	ChallengeID string `json:"challengeId"`
+	ActionType  string `json:"actionType"`
+	Code        string `json:"code"`
+}
+
+// readChallenge decodes a challenge request and looks up the account of its ticket.
+func (s *Server) readChallenge(w http.ResponseWriter, r *http.Request) (challengeRequest, *Account, bool) {
+	var challenge challengeRequest
+	if err := json.NewDecoder(r.Body).Decode(&challenge); err != nil || challenge.ActionType != "Login" {
+		writeError(w, http.StatusBadRequest, 0, "Invalid request")
+		return challenge, nil, false
+	}
+
+	s.mu.Lock()
+	account, found := s.tickets[challenge.ChallengeID]
+	s.mu.Unlock()
+
+	if !found || strconv.FormatInt(account.ID, 10) != r.PathValue("userId") {
+		writeError(w, http.StatusBadRequest, 5, "Invalid challenge.")
+		return challenge, nil, false
+	}
+
+	return challenge, account, true
+}
+
+// setCookie sets the .ROBLOSECURITY cookie.
+func setCookie(w http.ResponseWriter, value string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     ".ROBLOSECURITY",
+		Value:    value,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true,
+	})
+}
+
+// writeJSON writes a successful JSON response.
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes an error response in the format of the Roblox API.
+func writeError(w http.ResponseWriter, status, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]any{{"code": code, "message": message}},
+	})
+}
+
+// redirect is client middleware that rewrites request URLs to the server.
+type redirect struct {
+	target *url.URL
+}
+
+// Process sends the request to the server instead of its original host.
+func (m *redirect) Process(ctx context.Context, httpClient *http.Client, req *http.Request, next middleware.NextFunc) (*http.Response, error) {
+	req.URL.Scheme = m.target.Scheme
+	req.URL.Host = m.target.Host
+	req.Host = m.target.Host
+
+	return next(ctx, httpClient, req)
+}
+
+// SetLogger is a no-op; the redirect has nothing to log.
+func (m *redirect) SetLogger(logger.Logger) {}
@@ -0,0 +1,192 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+var (
+	ErrTwoStepRequired = errors.New("two-step verification required but no verifier was provided")
+	ErrCookieNotFound  = errors.New(".ROBLOSECURITY cookie not found in response")
+)
+
+// twoStepActionType is the action two-step verification is performed for.
+const twoStepActionType = "Login"
+
+// mediaTypePaths maps media types to their path in the two-step verification endpoints.
+var mediaTypePaths = map[types.TwoStepMediaType]string{
+	types.TwoStepMediaTypeAuthenticator: "authenticator",
+	types.TwoStepMediaTypeEmail:         "email",
+	types.TwoStepMediaTypeSMS:           "sms",
+	types.TwoStepMediaTypeRecoveryCode:  "recovery-codes",
+}
+
+// Login logs in with a username, email or phone number and a password, and returns the
+// resulting .ROBLOSECURITY cookie. If the account has two-step verification enabled,
+// the verifier is asked for the code to complete it.
+//
+// Captcha and other rblx-challenge responses are not handled here; they surface as API errors.
+// POST https://auth.roblox.com/v2/login
+func (r *Resource) Login(ctx context.Context, p LoginParams, verifier TwoStepVerifier) (*types.LoginResult, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var login types.LoginResponse
+
+	resp, err := r.post(ctx, types.AuthEndpoint+"/v2/login", p, &login)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&login); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	// Without two-step verification the cookie is set right away
+	if login.TwoStepVerificationData == nil {
+		cookie, err := cookieFromResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.LoginResult{User: login.User, Cookie: cookie, IsBanned: login.IsBanned}, nil
+	}
+
+	if verifier == nil {
+		return nil, ErrTwoStepRequired
+	}
+
+	challenge := TwoStepChallenge{
+		UserID:    login.User.ID,
+		MediaType: login.TwoStepVerificationData.MediaType,
+		Ticket:    login.TwoStepVerificationData.Ticket,
+	}
+
+	cookie, err := r.completeTwoStep(ctx, challenge, verifier, p.RememberDevice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.LoginResult{User: login.User, Cookie: cookie, IsBanned: login.IsBanned}, nil
+}
+
+// completeTwoStep answers a two-step verification challenge and finishes the login.
+func (r *Resource) completeTwoStep(ctx context.Context, challenge TwoStepChallenge, verifier TwoStepVerifier, rememberDevice bool) (string, error) {
+	path, ok := mediaTypePaths[challenge.MediaType]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedMediaType, challenge.MediaType)
+	}
+
+	challengesURL := fmt.Sprintf("%s/v1/users/%d/challenges/%s", types.TwoStepVerificationEndpoint, challenge.UserID, path)
+
+	// Email codes are only sent on request
+	if challenge.MediaType == types.TwoStepMediaTypeEmail {
+		resp, err := r.post(ctx, challengesURL+"/send-code", map[string]string{
+			"challengeId": challenge.Ticket,
+			"actionType":  twoStepActionType,
+		}, nil)
+		if err != nil {
+			return "", err
+		}
+
+		_ = resp.Body.Close()
+	}
+
+	code, err := verifier.Code(ctx, challenge)
+	if err != nil {
+		return "", err
+	}
+
+	var verified types.TwoStepVerifyResponse
+
+	resp, err := r.post(ctx, challengesURL+"/verify", map[string]string{
+		"challengeId": challenge.Ticket,
+		"actionType":  twoStepActionType,
+		"code":        code,
+	}, &verified)
+	if err != nil {
+		return "", err
+	}
+
+	_ = resp.Body.Close()
+
+	if err := r.validate.Struct(&verified); err != nil {
+		return "", fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	resp, err = r.post(ctx, fmt.Sprintf("%s/v3/users/%d/two-step-verification/login", types.AuthEndpoint, challenge.UserID), map[string]any{
+		"challengeId":       challenge.Ticket,
+		"verificationToken": verified.VerificationToken,
+		"rememberDevice":    rememberDevice,
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	return cookieFromResponse(resp)
+}
+
+// cookieFromResponse returns the value of the .ROBLOSECURITY cookie set by a response.
+func cookieFromResponse(resp *http.Response) (string, error) {
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == ".ROBLOSECURITY" && cookie.Value != "" {
+			return cookie.Value, nil
+		}
+	}
+
+	return "", ErrCookieNotFound
+}
+
+// LoginParams holds the parameters for logging in.
+type LoginParams struct {
+	CredentialType  types.CredentialType `json:"ctype"    validate:"required,oneof=Username Email PhoneNumber"`
+	CredentialValue string               `json:"cvalue"   validate:"required"`
+	Password        string               `json:"password" validate:"required"`
+	RememberDevice  bool                 `json:"-"`
+}
+
+// LoginBuilder is a builder for LoginParams.
+type LoginBuilder struct {
+	params LoginParams
+}
+
+// NewLoginBuilder creates a new LoginBuilder that logs in with a username.
+func NewLoginBuilder(username, password string) *LoginBuilder {
+	return &LoginBuilder{
+		params: LoginParams{
+			CredentialType:  types.CredentialTypeUsername,
+			CredentialValue: username,
+			Password:        password,
+			RememberDevice:  false,
+		},
+	}
+}
+
+// WithCredential sets the kind of identifier and its value, such as an email address.
+func (b *LoginBuilder) WithCredential(credentialType types.CredentialType, value string) *LoginBuilder {
+	b.params.CredentialType = credentialType
+	b.params.CredentialValue = value
+
+	return b
+}
+
+// WithRememberDevice sets whether two-step verification is skipped for this device in the future.
+func (b *LoginBuilder) WithRememberDevice(rememberDevice bool) *LoginBuilder {
+	b.params.RememberDevice = rememberDevice
+	return b
+}
+
+// Build returns the LoginParams.
+func (b *LoginBuilder) Build() LoginParams {
+	return b.params
+}
@@ -0,0 +1,139 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/resources/auth"
+	"github.com/jaxron/roapi.go/pkg/api/resources/auth/authtest"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleTOTPSecret = "JBSWY3DPEHPK3PXP"
+
+var errMailboxEmpty = errors.New("mailbox empty")
+
+func TestLogin(t *testing.T) {
+	server := authtest.NewServer(
+		authtest.Account{ID: 1, Username: "plain", Email: "plain@example.com", Password: "hunter2", Cookie: "plain-cookie"},
+		authtest.Account{
+			ID: 2, Username: "totp", Password: "hunter2", Cookie: "totp-cookie",
+			MediaType: types.TwoStepMediaTypeAuthenticator, TOTPSecret: sampleTOTPSecret,
+		},
+		authtest.Account{
+			ID: 3, Username: "email", Password: "hunter2", Cookie: "email-cookie",
+			MediaType: types.TwoStepMediaTypeEmail, EmailCode: "123456",
+		},
+		authtest.Account{
+			ID: 4, Username: "sms", Password: "hunter2", Cookie: "sms-cookie",
+			MediaType: types.TwoStepMediaTypeSMS,
+		},
+	)
+	defer server.Close()
+
+	api := auth.New(
+		client.NewClient(client.WithMiddleware(server.Middleware())),
+		validator.New(validator.WithRequiredStructEnabled()),
+	)
+
+	t.Run("Login Without Two-Step Verification", func(t *testing.T) {
+		result, err := api.Login(context.Background(), auth.NewLoginBuilder("plain", "hunter2").Build(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, "plain-cookie", result.Cookie)
+		assert.Equal(t, int64(1), result.User.ID)
+		assert.Equal(t, "plain", result.User.Name)
+		assert.False(t, result.IsBanned)
+	})
+
+	t.Run("Login With Email Credential", func(t *testing.T) {
+		builder := auth.NewLoginBuilder("", "hunter2").WithCredential(types.CredentialTypeEmail, "plain@example.com")
+		result, err := api.Login(context.Background(), builder.Build(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, "plain-cookie", result.Cookie)
+	})
+
+	t.Run("Login With Authenticator", func(t *testing.T) {
+		builder := auth.NewLoginBuilder("totp", "hunter2").WithRememberDevice(true)
+		result, err := api.Login(context.Background(), builder.Build(), auth.NewTOTPVerifier(sampleTOTPSecret))
+		require.NoError(t, err)
+		assert.Equal(t, "totp-cookie", result.Cookie)
+		assert.Equal(t, int64(2), result.User.ID)
+	})
+
+	t.Run("Login With Email Code", func(t *testing.T) {
+		var received auth.TwoStepChallenge
+
+		verifier := auth.NewEmailVerifier(func(_ context.Context, challenge auth.TwoStepChallenge) (string, error) {
+			received = challenge
+			return "123456", nil
+		})
+
+		result, err := api.Login(context.Background(), auth.NewLoginBuilder("email", "hunter2").Build(), verifier)
+		require.NoError(t, err)
+		assert.Equal(t, "email-cookie", result.Cookie)
+		assert.Equal(t, int64(3), received.UserID)
+		assert.Equal(t, types.TwoStepMediaTypeEmail, received.MediaType)
+		assert.NotEmpty(t, received.Ticket)
+		assert.Contains(t, server.Requests(), "/v1/users/3/challenges/email/send-code")
+	})
+
+	t.Run("Wrong Two-Step Code", func(t *testing.T) {
+		verifier := auth.TwoStepVerifierFunc(func(context.Context, auth.TwoStepChallenge) (string, error) {
+			return "000000", nil
+		})
+
+		_, err := api.Login(context.Background(), auth.NewLoginBuilder("email", "hunter2").Build(), verifier)
+
+		var apiErr *errs.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, 10, apiErr.Errors[0].Code)
+	})
+
+	t.Run("Verifier Errors Are Returned", func(t *testing.T) {
+		verifier := auth.NewEmailVerifier(func(context.Context, auth.TwoStepChallenge) (string, error) {
+			return "", errMailboxEmpty
+		})
+
+		_, err := api.Login(context.Background(), auth.NewLoginBuilder("email", "hunter2").Build(), verifier)
+		require.ErrorIs(t, err, errMailboxEmpty)
+	})
+
+	t.Run("Verifier For Other Media Type", func(t *testing.T) {
+		_, err := api.Login(context.Background(), auth.NewLoginBuilder("email", "hunter2").Build(), auth.NewTOTPVerifier(sampleTOTPSecret))
+		require.ErrorIs(t, err, auth.ErrUnsupportedMediaType)
+	})
+
+	t.Run("Unsupported Media Type", func(t *testing.T) {
+		verifier := auth.TwoStepVerifierFunc(func(context.Context, auth.TwoStepChallenge) (string, error) {
+			return "123456", nil
+		})
+
+		_, err := api.Login(context.Background(), auth.NewLoginBuilder("sms", "hunter2").Build(), verifier)
+		require.Error(t, err)
+	})
+
+	t.Run("Two-Step Required Without Verifier", func(t *testing.T) {
+		_, err := api.Login(context.Background(), auth.NewLoginBuilder("totp", "hunter2").Build(), nil)
+		require.ErrorIs(t, err, auth.ErrTwoStepRequired)
+	})
+
+	t.Run("Incorrect Password", func(t *testing.T) {
+		_, err := api.Login(context.Background(), auth.NewLoginBuilder("plain", "wrong").Build(), nil)
+
+		var apiErr *errs.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, 1, apiErr.Errors[0].Code)
+	})
+
+	t.Run("Missing Password", func(t *testing.T) {
+		_, err := api.Login(context.Background(), auth.NewLoginBuilder("plain", "").Build(), nil)
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "Password")
+	})
+}
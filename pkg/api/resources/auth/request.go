@@ -0,0 +1,15 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/internal/csrf"
+)
+
+// post sends a JSON request with the cached CSRF token. If the token is missing or
+// expired, the server rejects the request with a new token, and the request is sent
+// once more with it.
+func (r *Resource) post(ctx context.Context, url string, body, result any) (*http.Response, error) {
+	return csrf.Post(ctx, r.client, &r.csrfToken, url, body, result)
+}
@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/internal/csrf"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ResourceInterface defines the interface for authentication-related operations.
+type ResourceInterface interface {
+	Login(ctx context.Context, p LoginParams, verifier TwoStepVerifier) (*types.LoginResult, error)
+}
+
+// Ensure Resource implements the ResourceInterface.
+var _ ResourceInterface = (*Resource)(nil)
+
+// Resource provides methods for interacting with authentication-related endpoints.
+//
+// Unlike other resources it does not rely on the auth middleware, as there is no cookie
+// before logging in. CSRF tokens are fetched and cached by the resource itself.
+type Resource struct {
+	client   *client.Client
+	validate *validator.Validate
+
+	csrfToken csrf.Token
+}
+
+// New creates a new Resource with the specified client and validator.
+func New(client *client.Client, validate *validator.Validate) *Resource {
+	return &Resource{
+		client:   client,
+		validate: validate,
+	}
+}
@@ -0,0 +1,41 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jaxron/roapi.go/pkg/api/resources/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTOTP(t *testing.T) {
+	// Test vectors from RFC 6238 appendix B, truncated to six digits
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" // base32 of "12345678901234567890"
+
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+
+	for unix, expected := range vectors {
+		code, err := auth.GenerateTOTP(secret, time.Unix(unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, expected, code, "time %d", unix)
+	}
+
+	t.Run("Lowercase Secret With Spaces", func(t *testing.T) {
+		code, err := auth.GenerateTOTP("gezd gnbv gy3t qojq gezd gnbv gy3t qojq", time.Unix(59, 0))
+		require.NoError(t, err)
+		assert.Equal(t, "287082", code)
+	})
+
+	t.Run("Invalid Secret", func(t *testing.T) {
+		_, err := auth.GenerateTOTP("not base32!", time.Now())
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ErrUnsupportedMediaType is returned when a verifier cannot answer a challenge of the given media type.
+var ErrUnsupportedMediaType = errors.New("unsupported two-step verification media type")
+
+// TwoStepChallenge describes a two-step verification challenge raised during login.
+type TwoStepChallenge struct {
+	UserID    int64                  // ID of the user logging in
+	MediaType types.TwoStepMediaType // Method the code is delivered with
+	Ticket    string                 // Challenge ticket
+}
+
+// TwoStepVerifier provides the code that answers a two-step verification challenge.
+// For email challenges, the code has already been requested when Code is called.
+type TwoStepVerifier interface {
+	Code(ctx context.Context, challenge TwoStepChallenge) (string, error)
+}
+
+// TwoStepVerifierFunc adapts a function to the TwoStepVerifier interface.
+type TwoStepVerifierFunc func(ctx context.Context, challenge TwoStepChallenge) (string, error)
+
+// Code calls f.
+func (f TwoStepVerifierFunc) Code(ctx context.Context, challenge TwoStepChallenge) (string, error) {
+	return f(ctx, challenge)
+}
+
+// TOTPVerifier answers authenticator challenges with codes generated from the account's
+// authenticator secret.
+type TOTPVerifier struct {
+	secret string
+	now    func() time.Time
+}
+
+// NewTOTPVerifier creates a new TOTPVerifier for the given base32 authenticator secret.
+func NewTOTPVerifier(secret string) *TOTPVerifier {
+	return &TOTPVerifier{
+		secret: secret,
+		now:    time.Now,
+	}
+}
+
+// SetNowFunc sets a custom function for getting the current time (useful for testing).
+func (v *TOTPVerifier) SetNowFunc(f func() time.Time) {
+	v.now = f
+}
+
+// Code returns the current authenticator code.
+func (v *TOTPVerifier) Code(_ context.Context, challenge TwoStepChallenge) (string, error) {
+	if challenge.MediaType != types.TwoStepMediaTypeAuthenticator {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedMediaType, challenge.MediaType)
+	}
+
+	return GenerateTOTP(v.secret, v.now())
+}
+
+// EmailVerifier answers email challenges with codes retrieved by the given function,
+// such as one that polls the account's mailbox.
+type EmailVerifier struct {
+	fetch func(ctx context.Context, challenge TwoStepChallenge) (string, error)
+}
+
+// NewEmailVerifier creates a new EmailVerifier that retrieves codes with fetch.
+// The code has already been sent when fetch is called.
+func NewEmailVerifier(fetch func(ctx context.Context, challenge TwoStepChallenge) (string, error)) *EmailVerifier {
+	return &EmailVerifier{
+		fetch: fetch,
+	}
+}
+
+// Code retrieves the emailed code.
+func (v *EmailVerifier) Code(ctx context.Context, challenge TwoStepChallenge) (string, error) {
+	if challenge.MediaType != types.TwoStepMediaTypeEmail {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedMediaType, challenge.MediaType)
+	}
+
+	return v.fetch(ctx, challenge)
+}
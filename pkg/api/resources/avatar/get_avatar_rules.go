@@ -0,0 +1,33 @@
+package avatar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetAvatarRules fetches the scale limits, wearable asset types and defaults that apply to avatars.
+// GET https://avatar.roblox.com/v1/avatar-rules
+func (r *Resource) GetAvatarRules(ctx context.Context) (*types.AvatarRulesResponse, error) {
+	var rules types.AvatarRulesResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(types.AvatarEndpoint + "/v1/avatar-rules").
+		Result(&rules).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&rules); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &rules, nil
+}
@@ -0,0 +1,38 @@
+package avatar_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/avatar"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAvatarRules(t *testing.T) {
+	// Create a new test resource
+	api := avatar.New(utils.NewTestEnv())
+
+	t.Run("Fetch Avatar Rules", func(t *testing.T) {
+		rules, err := api.GetAvatarRules(context.Background())
+		require.NoError(t, err)
+		assert.NotNil(t, rules)
+
+		assert.Contains(t, rules.PlayerAvatarTypes, types.PlayerAvatarTypeR15)
+		assert.NotEmpty(t, rules.WearableAssetTypes)
+		assert.Less(t, rules.Scales.Height.Min, rules.Scales.Height.Max)
+	})
+
+	t.Run("Validate Known Outfit", func(t *testing.T) {
+		rules, err := api.GetAvatarRules(context.Background())
+		require.NoError(t, err)
+
+		outfit, err := api.GetOutfitDetails(context.Background(), utils.SampleOutfitID)
+		require.NoError(t, err)
+
+		err = avatar.NewOutfitValidator(rules).ValidateOutfit(outfit)
+		require.NoError(t, err)
+	})
+}
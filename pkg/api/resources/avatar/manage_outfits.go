@@ -0,0 +1,177 @@
+package avatar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// CreateOutfit creates a new outfit for the authenticated user.
+// POST https://avatar.roblox.com/v2/outfits/create
+func (r *Resource) CreateOutfit(ctx context.Context, p OutfitParams) (*types.Outfit, error) {
+	return r.saveOutfit(ctx, http.MethodPost, types.AvatarEndpoint+"/v2/outfits/create", p)
+}
+
+// UpdateOutfit replaces the contents of an existing outfit owned by the authenticated user.
+// PATCH https://avatar.roblox.com/v2/outfits/{userOutfitId}
+func (r *Resource) UpdateOutfit(ctx context.Context, outfitID int64, p OutfitParams) (*types.Outfit, error) {
+	if err := r.validate.Var(outfitID, "required,gt=0"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	return r.saveOutfit(ctx, http.MethodPatch, fmt.Sprintf("%s/v2/outfits/%d", types.AvatarEndpoint, outfitID), p)
+}
+
+// DeleteOutfit deletes an outfit owned by the authenticated user.
+// POST https://avatar.roblox.com/v1/outfits/{userOutfitId}/delete
+func (r *Resource) DeleteOutfit(ctx context.Context, outfitID int64) error {
+	if err := r.validate.Var(outfitID, "required,gt=0"); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	return r.postAvatarUpdate(ctx, fmt.Sprintf("%s/v1/outfits/%d/delete", types.AvatarEndpoint, outfitID), nil)
+}
+
+// WearOutfit makes the authenticated user wear an outfit they own.
+// Assets the user cannot wear are reported in the response rather than as an error.
+// POST https://avatar.roblox.com/v1/outfits/{userOutfitId}/wear
+func (r *Resource) WearOutfit(ctx context.Context, outfitID int64) (*types.WearAssetsResponse, error) {
+	if err := r.validate.Var(outfitID, "required,gt=0"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+	ctx = context.WithValue(ctx, auth.KeyAddToken, true)
+
+	var result types.WearAssetsResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(fmt.Sprintf("%s/v1/outfits/%d/wear", types.AvatarEndpoint, outfitID)).
+		Result(&result).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&result); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &result, nil
+}
+
+// saveOutfit validates the outfit and sends it to the create or update endpoint.
+func (r *Resource) saveOutfit(ctx context.Context, method, url string, p OutfitParams) (*types.Outfit, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+	ctx = context.WithValue(ctx, auth.KeyAddToken, true)
+
+	var result types.Outfit
+
+	resp, err := r.client.NewRequest().
+		Method(method).
+		URL(url).
+		MarshalBody(p).
+		Result(&result).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&result); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &result, nil
+}
+
+// OutfitParams holds the contents of an outfit to create or update.
+type OutfitParams struct {
+	Name             string                 `json:"name"             validate:"required,max=100"`
+	Assets           []WearAssetRequest     `json:"assets"           validate:"max=100,dive"`
+	BodyColors       types.BodyColors3      `json:"bodyColor3s"      validate:"required"`
+	Scale            types.ScaleModel       `json:"scale"            validate:"required"`
+	PlayerAvatarType types.PlayerAvatarType `json:"playerAvatarType" validate:"required,oneof=R6 R15"`
+	OutfitType       types.AvatarOutfitType `json:"outfitType"       validate:"required"`
+}
+
+// OutfitBuilder is a builder for OutfitParams.
+type OutfitBuilder struct {
+	params OutfitParams
+}
+
+// NewOutfitBuilder creates a new OutfitBuilder with the given name, body colors and scales,
+// and default values for the rest. The body colors and scales have no valid defaults.
+func NewOutfitBuilder(name string, colors types.BodyColors3, scale types.ScaleModel) *OutfitBuilder {
+	return &OutfitBuilder{
+		params: OutfitParams{
+			Name:             name,
+			Assets:           []WearAssetRequest{},
+			BodyColors:       colors,
+			Scale:            scale,
+			PlayerAvatarType: types.PlayerAvatarTypeR15,
+			OutfitType:       types.AvatarOutfitTypeAvatar,
+		},
+	}
+}
+
+// NewOutfitBuilderFromAvatar creates a new OutfitBuilder that captures the given avatar,
+// which is typically the authenticated user's current avatar.
+func NewOutfitBuilderFromAvatar(name string, avatar *types.UserAvatarResponse) *OutfitBuilder {
+	return NewOutfitBuilder(name, avatar.BodyColors, avatar.Scales).
+		WithAssets(NewSetWearingAssetsBuilderFromAssets(avatar.Assets).Build().Assets...).
+		WithPlayerAvatarType(types.PlayerAvatarType(avatar.PlayerAvatarType))
+}
+
+// WithName sets the outfit name.
+func (b *OutfitBuilder) WithName(name string) *OutfitBuilder {
+	b.params.Name = name
+	return b
+}
+
+// WithAssets adds multiple assets.
+func (b *OutfitBuilder) WithAssets(assets ...WearAssetRequest) *OutfitBuilder {
+	b.params.Assets = append(b.params.Assets, assets...)
+	return b
+}
+
+// WithBodyColors sets the body colors.
+func (b *OutfitBuilder) WithBodyColors(colors types.BodyColors3) *OutfitBuilder {
+	b.params.BodyColors = colors
+	return b
+}
+
+// WithScale sets the body scales.
+func (b *OutfitBuilder) WithScale(scale types.ScaleModel) *OutfitBuilder {
+	b.params.Scale = scale
+	return b
+}
+
+// WithPlayerAvatarType sets the rig type.
+func (b *OutfitBuilder) WithPlayerAvatarType(avatarType types.PlayerAvatarType) *OutfitBuilder {
+	b.params.PlayerAvatarType = avatarType
+	return b
+}
+
+// WithOutfitType sets the outfit type.
+func (b *OutfitBuilder) WithOutfitType(outfitType types.AvatarOutfitType) *OutfitBuilder {
+	b.params.OutfitType = outfitType
+	return b
+}
+
+// Build returns the OutfitParams.
+func (b *OutfitBuilder) Build() OutfitParams {
+	return b.params
+}
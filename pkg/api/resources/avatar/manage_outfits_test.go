@@ -0,0 +1,92 @@
+package avatar_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/avatar"
+	"github.com/jaxron/roapi.go/pkg/api/resources/users"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/jaxron/roapi.go/pkg/api/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bodyColors is a valid set of body colors for building outfits.
+var bodyColors = types.BodyColors3{
+	HeadColor3:     "F8D9B8",
+	TorsoColor3:    "F8D9B8",
+	RightArmColor3: "F8D9B8",
+	LeftArmColor3:  "F8D9B8",
+	RightLegColor3: "F8D9B8",
+	LeftLegColor3:  "F8D9B8",
+}
+
+func TestManageOutfits(t *testing.T) {
+	// Create a new test resource
+	c, v := utils.NewTestEnv()
+	api := avatar.New(c, v)
+
+	t.Run("Create Update Wear And Delete Outfit", func(t *testing.T) {
+		user, err := users.New(c, v).GetAuthUserInfo(context.Background())
+		require.NoError(t, err)
+
+		current, err := api.GetUserAvatar(context.Background(), user.ID)
+		require.NoError(t, err)
+
+		builder := avatar.NewOutfitBuilderFromAvatar("roapi test outfit", current)
+		outfit, err := api.CreateOutfit(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotZero(t, outfit.ID)
+
+		defer func() {
+			err := api.DeleteOutfit(context.Background(), outfit.ID)
+			require.NoError(t, err)
+		}()
+
+		updated, err := api.UpdateOutfit(context.Background(), outfit.ID, builder.WithName("roapi test outfit 2").Build())
+		require.NoError(t, err)
+		assert.Equal(t, outfit.ID, updated.ID)
+
+		result, err := api.WearOutfit(context.Background(), outfit.ID)
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+	})
+
+	t.Run("Missing Outfit Name", func(t *testing.T) {
+		_, err := api.CreateOutfit(context.Background(), avatar.NewOutfitBuilder("", bodyColors, types.ScaleModel{Height: 1, Width: 1, Head: 1, Depth: 1}).Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Name")
+	})
+
+	t.Run("Invalid Outfit ID", func(t *testing.T) {
+		err := api.DeleteOutfit(context.Background(), 0)
+		require.Error(t, err)
+
+		_, err = api.WearOutfit(context.Background(), 0)
+		require.Error(t, err)
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		scale := types.ScaleModel{Height: 1, Width: 1, Head: 1, Depth: 1}
+		builder := avatar.NewOutfitBuilder("outfit", bodyColors, types.ScaleModel{}).
+			WithAssets(avatar.WearAssetRequest{ID: utils.SampleAssetID}).
+			WithScale(scale).
+			WithPlayerAvatarType(types.PlayerAvatarTypeR6).
+			WithOutfitType(types.AvatarOutfitTypeAvatar)
+
+		params := builder.Build()
+		assert.Equal(t, "outfit", params.Name)
+		assert.Len(t, params.Assets, 1)
+		assert.Equal(t, bodyColors, params.BodyColors)
+		assert.Equal(t, scale, params.Scale)
+		assert.Equal(t, types.PlayerAvatarTypeR6, params.PlayerAvatarType)
+		assert.Equal(t, types.AvatarOutfitTypeAvatar, params.OutfitType)
+	})
+}
+
+func TestOutfitBuilder(t *testing.T) {
+	params := avatar.NewOutfitBuilder("outfit", bodyColors, types.ScaleModel{Height: 1, Width: 1, Head: 1, Depth: 1}).Build()
+	require.NoError(t, validation.New().Struct(params))
+}
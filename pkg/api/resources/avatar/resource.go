@@ -13,6 +13,16 @@ type ResourceInterface interface {
 	GetUserOutfits(ctx context.Context, p UserOutfitsParams) (*types.OutfitResponse, error)
 	GetOutfitDetails(ctx context.Context, outfitID int64) (*types.OutfitDetailsResponse, error)
 	GetUserAvatar(ctx context.Context, userID int64) (*types.UserAvatarResponse, error)
+	GetAvatarRules(ctx context.Context) (*types.AvatarRulesResponse, error)
+	SetWearingAssets(ctx context.Context, p SetWearingAssetsParams) (*types.WearAssetsResponse, error)
+	SetBodyColors(ctx context.Context, colors types.BodyColors3) error
+	SetScales(ctx context.Context, scales types.ScaleModel) error
+	SetPlayerAvatarType(ctx context.Context, avatarType types.PlayerAvatarType) error
+	RedrawThumbnail(ctx context.Context) error
+	CreateOutfit(ctx context.Context, p OutfitParams) (*types.Outfit, error)
+	UpdateOutfit(ctx context.Context, outfitID int64, p OutfitParams) (*types.Outfit, error)
+	DeleteOutfit(ctx context.Context, outfitID int64) error
+	WearOutfit(ctx context.Context, outfitID int64) (*types.WearAssetsResponse, error)
 }
 
 // Ensure Resource implements the ResourceInterface.
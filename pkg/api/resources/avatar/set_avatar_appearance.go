@@ -0,0 +1,85 @@
+package avatar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ErrUpdateFailed is returned when the avatar endpoints accept a request but report it as unsuccessful.
+var ErrUpdateFailed = errors.New("avatar update was not applied")
+
+// SetBodyColors sets the body colors of the authenticated user.
+// POST https://avatar.roblox.com/v2/avatar/set-body-colors
+func (r *Resource) SetBodyColors(ctx context.Context, colors types.BodyColors3) error {
+	if err := r.validate.Struct(colors); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	return r.postAvatarUpdate(ctx, types.AvatarEndpoint+"/v2/avatar/set-body-colors", colors)
+}
+
+// SetScales sets the body scales of the authenticated user.
+// POST https://avatar.roblox.com/v1/avatar/set-scales
+func (r *Resource) SetScales(ctx context.Context, scales types.ScaleModel) error {
+	if err := r.validate.Struct(scales); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	return r.postAvatarUpdate(ctx, types.AvatarEndpoint+"/v1/avatar/set-scales", scales)
+}
+
+// SetPlayerAvatarType sets the rig type (R6 or R15) of the authenticated user.
+// POST https://avatar.roblox.com/v1/avatar/set-player-avatar-type
+func (r *Resource) SetPlayerAvatarType(ctx context.Context, avatarType types.PlayerAvatarType) error {
+	if err := r.validate.Var(avatarType, "required,oneof=R6 R15"); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	return r.postAvatarUpdate(ctx, types.AvatarEndpoint+"/v1/avatar/set-player-avatar-type", struct {
+		PlayerAvatarType types.PlayerAvatarType `json:"playerAvatarType"`
+	}{
+		PlayerAvatarType: avatarType,
+	})
+}
+
+// RedrawThumbnail requests the thumbnails of the authenticated user to be regenerated.
+// POST https://avatar.roblox.com/v1/avatar/redraw-thumbnail
+func (r *Resource) RedrawThumbnail(ctx context.Context) error {
+	return r.postAvatarUpdate(ctx, types.AvatarEndpoint+"/v1/avatar/redraw-thumbnail", nil)
+}
+
+// postAvatarUpdate sends an authenticated avatar update and checks that it was applied.
+func (r *Resource) postAvatarUpdate(ctx context.Context, url string, body any) error {
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+	ctx = context.WithValue(ctx, auth.KeyAddToken, true)
+
+	var result types.AvatarSuccessResponse
+
+	req := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(url).
+		Result(&result)
+
+	if body != nil {
+		req.MarshalBody(body)
+	}
+
+	resp, err := req.Do(ctx)
+	if err != nil {
+		return errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if !result.Success {
+		return ErrUpdateFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package avatar_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/avatar"
+	"github.com/jaxron/roapi.go/pkg/api/resources/users"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAvatarAppearance(t *testing.T) {
+	// Create a new test resource
+	c, v := utils.NewTestEnv()
+	api := avatar.New(c, v)
+
+	user, err := users.New(c, v).GetAuthUserInfo(context.Background())
+	require.NoError(t, err)
+
+	current, err := api.GetUserAvatar(context.Background(), user.ID)
+	require.NoError(t, err)
+
+	t.Run("Reapply Current Body Colors", func(t *testing.T) {
+		err := api.SetBodyColors(context.Background(), current.BodyColors)
+		require.NoError(t, err)
+	})
+
+	t.Run("Reapply Current Scales", func(t *testing.T) {
+		err := api.SetScales(context.Background(), current.Scales)
+		require.NoError(t, err)
+	})
+
+	t.Run("Reapply Current Avatar Type", func(t *testing.T) {
+		err := api.SetPlayerAvatarType(context.Background(), types.PlayerAvatarType(current.PlayerAvatarType))
+		require.NoError(t, err)
+	})
+
+	t.Run("Redraw Thumbnail", func(t *testing.T) {
+		err := api.RedrawThumbnail(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("Invalid Avatar Type", func(t *testing.T) {
+		err := api.SetPlayerAvatarType(context.Background(), "R9")
+		require.Error(t, err)
+	})
+
+	t.Run("Missing Body Colors", func(t *testing.T) {
+		err := api.SetBodyColors(context.Background(), types.BodyColors3{})
+		require.Error(t, err)
+	})
+}
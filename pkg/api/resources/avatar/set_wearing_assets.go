@@ -0,0 +1,117 @@
+package avatar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// SetWearingAssets replaces the assets worn by the authenticated user.
+// Assets the user cannot wear are reported in the response rather than as an error.
+// POST https://avatar.roblox.com/v2/avatar/set-wearing-assets
+func (r *Resource) SetWearingAssets(ctx context.Context, p SetWearingAssetsParams) (*types.WearAssetsResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+	ctx = context.WithValue(ctx, auth.KeyAddToken, true)
+
+	var result types.WearAssetsResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(types.AvatarEndpoint + "/v2/avatar/set-wearing-assets").
+		MarshalBody(p).
+		Result(&result).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&result); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &result, nil
+}
+
+// WearAssetRequest represents a single asset to wear.
+type WearAssetRequest struct {
+	ID   int64              `json:"id"             validate:"required,gt=0"` // Asset ID
+	Meta *types.AssetMetaV1 `json:"meta,omitempty"`                          // Layered clothing metadata (if any)
+}
+
+// SetWearingAssetsParams holds the parameters for setting the worn assets.
+// An empty list of assets removes everything the user is wearing.
+type SetWearingAssetsParams struct {
+	Assets []WearAssetRequest `json:"assets" validate:"max=100,dive"`
+}
+
+// SetWearingAssetsBuilder is a builder for SetWearingAssetsParams.
+type SetWearingAssetsBuilder struct {
+	params SetWearingAssetsParams
+}
+
+// NewSetWearingAssetsBuilder creates a new SetWearingAssetsBuilder with the given asset IDs.
+func NewSetWearingAssetsBuilder(assetIDs ...int64) *SetWearingAssetsBuilder {
+	b := &SetWearingAssetsBuilder{
+		params: SetWearingAssetsParams{
+			Assets: make([]WearAssetRequest, 0, len(assetIDs)),
+		},
+	}
+
+	return b.WithAssets(assetIDs...)
+}
+
+// NewSetWearingAssetsBuilderFromAssets creates a new SetWearingAssetsBuilder from assets
+// returned by the avatar endpoints, keeping their layered clothing metadata.
+func NewSetWearingAssetsBuilderFromAssets(assets []*types.AssetV2) *SetWearingAssetsBuilder {
+	b := &SetWearingAssetsBuilder{
+		params: SetWearingAssetsParams{
+			Assets: make([]WearAssetRequest, 0, len(assets)),
+		},
+	}
+
+	for _, asset := range assets {
+		b.WithLayeredAsset(asset.ID, asset.Meta)
+	}
+
+	return b
+}
+
+// WithAssets adds multiple assets without metadata.
+func (b *SetWearingAssetsBuilder) WithAssets(assetIDs ...int64) *SetWearingAssetsBuilder {
+	for _, id := range assetIDs {
+		b.params.Assets = append(b.params.Assets, WearAssetRequest{ID: id, Meta: nil})
+	}
+
+	return b
+}
+
+// WithLayeredAsset adds an asset with layered clothing metadata.
+func (b *SetWearingAssetsBuilder) WithLayeredAsset(assetID int64, meta *types.AssetMetaV1) *SetWearingAssetsBuilder {
+	b.params.Assets = append(b.params.Assets, WearAssetRequest{ID: assetID, Meta: meta})
+	return b
+}
+
+// RemoveAssets removes assets by ID.
+func (b *SetWearingAssetsBuilder) RemoveAssets(assetIDs ...int64) *SetWearingAssetsBuilder {
+	b.params.Assets = slices.DeleteFunc(b.params.Assets, func(asset WearAssetRequest) bool {
+		return slices.Contains(assetIDs, asset.ID)
+	})
+
+	return b
+}
+
+// Build returns the SetWearingAssetsParams.
+func (b *SetWearingAssetsBuilder) Build() SetWearingAssetsParams {
+	return b.params
+}
@@ -0,0 +1,57 @@
+package avatar_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/avatar"
+	"github.com/jaxron/roapi.go/pkg/api/resources/users"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetWearingAssets(t *testing.T) {
+	// Create a new test resource
+	c, v := utils.NewTestEnv()
+	api := avatar.New(c, v)
+
+	t.Run("Reapply Current Assets", func(t *testing.T) {
+		user, err := users.New(c, v).GetAuthUserInfo(context.Background())
+		require.NoError(t, err)
+
+		current, err := api.GetUserAvatar(context.Background(), user.ID)
+		require.NoError(t, err)
+
+		builder := avatar.NewSetWearingAssetsBuilderFromAssets(current.Assets)
+		result, err := api.SetWearingAssets(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Empty(t, result.InvalidAssetIDs)
+	})
+
+	t.Run("Invalid Asset ID", func(t *testing.T) {
+		builder := avatar.NewSetWearingAssetsBuilder(utils.InvalidAssetID)
+		_, err := api.SetWearingAssets(context.Background(), builder.Build())
+		require.Error(t, err)
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		order := int32(3)
+		meta := &types.AssetMetaV1{Order: &order, Version: 1}
+
+		builder := avatar.NewSetWearingAssetsBuilder(utils.SampleAssetID).
+			WithLayeredAsset(utils.SampleAssetID2, meta)
+
+		params := builder.Build()
+		assert.Len(t, params.Assets, 2)
+		assert.Nil(t, params.Assets[0].Meta)
+		assert.Equal(t, meta, params.Assets[1].Meta)
+
+		builder.RemoveAssets(utils.SampleAssetID)
+		params = builder.Build()
+		assert.Len(t, params.Assets, 1)
+		assert.Equal(t, utils.SampleAssetID2, params.Assets[0].ID)
+	})
+}
@@ -0,0 +1,169 @@
+package avatar
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ErrRuleViolation is wrapped by every error reported by the OutfitValidator.
+var ErrRuleViolation = errors.New("avatar rule violation")
+
+// scaleTolerance absorbs float rounding when comparing scales against their bounds.
+const scaleTolerance = 1e-6
+
+// layeredClothingTypes lists the asset types that are rendered as layered clothing.
+var layeredClothingTypes = []types.ItemAssetType{
+	types.ItemAssetTypeTShirtAccessory,
+	types.ItemAssetTypeShirtAccessory,
+	types.ItemAssetTypePantsAccessory,
+	types.ItemAssetTypeJacketAccessory,
+	types.ItemAssetTypeSweaterAccessory,
+	types.ItemAssetTypeShortsAccessory,
+	types.ItemAssetTypeLeftShoeAccessory,
+	types.ItemAssetTypeRightShoeAccessory,
+	types.ItemAssetTypeDressSkirtAccessory,
+}
+
+// OutfitValidator checks avatars and outfits against the avatar rules locally,
+// so that invalid changes can be caught before making write calls.
+type OutfitValidator struct {
+	rules    *types.AvatarRulesResponse
+	maxWorn  map[types.ItemAssetType]int64
+	typeName map[types.ItemAssetType]string
+}
+
+// NewOutfitValidator creates a new OutfitValidator from the rules returned by GetAvatarRules.
+func NewOutfitValidator(rules *types.AvatarRulesResponse) *OutfitValidator {
+	maxWorn := make(map[types.ItemAssetType]int64, len(rules.WearableAssetTypes))
+	typeName := make(map[types.ItemAssetType]string, len(rules.WearableAssetTypes))
+
+	for _, assetType := range rules.WearableAssetTypes {
+		maxWorn[assetType.ID] = assetType.MaxNumber
+		typeName[assetType.ID] = assetType.Name
+	}
+
+	return &OutfitValidator{
+		rules:    rules,
+		maxWorn:  maxWorn,
+		typeName: typeName,
+	}
+}
+
+// ValidateOutfit checks the rig type, scales and assets of an outfit.
+// All violations are returned together, each wrapping ErrRuleViolation.
+func (v *OutfitValidator) ValidateOutfit(outfit *types.OutfitDetailsResponse) error {
+	return errors.Join(
+		v.validatePlayerAvatarType(types.PlayerAvatarType(outfit.PlayerAvatarType)),
+		v.ValidateScales(outfit.Scale),
+		v.ValidateAssets(outfit.Assets),
+	)
+}
+
+// ValidateAvatar checks the rig type, scales and assets of an avatar,
+// such as one fetched with GetUserAvatar and modified before being saved.
+func (v *OutfitValidator) ValidateAvatar(avatar *types.UserAvatarResponse) error {
+	return errors.Join(
+		v.validatePlayerAvatarType(types.PlayerAvatarType(avatar.PlayerAvatarType)),
+		v.ValidateScales(avatar.Scales),
+		v.ValidateAssets(avatar.Assets),
+	)
+}
+
+// ValidateScales checks that every scale is within the allowed bounds.
+func (v *OutfitValidator) ValidateScales(scale types.ScaleModel) error {
+	rules := v.rules.Scales
+
+	return errors.Join(
+		checkScale("height", scale.Height, rules.Height),
+		checkScale("width", scale.Width, rules.Width),
+		checkScale("head", scale.Head, rules.Head),
+		checkScale("depth", scale.Depth, rules.Depth),
+		checkScale("proportion", scale.Proportion, rules.Proportion),
+		checkScale("bodyType", scale.BodyType, rules.BodyType),
+	)
+}
+
+// ValidateAssets checks a planned wearing set: every asset type must be wearable,
+// no type may exceed its wear limit, and layered clothing must have a unique order.
+func (v *OutfitValidator) ValidateAssets(assets []*types.AssetV2) error {
+	var violations []error
+
+	counts := make(map[types.ItemAssetType]int64)
+	orders := make(map[int32]int64)
+
+	for _, asset := range assets {
+		assetType := asset.AssetType.ID
+		counts[assetType]++
+
+		if !slices.Contains(layeredClothingTypes, assetType) {
+			continue
+		}
+
+		if asset.Meta == nil || asset.Meta.Order == nil {
+			violations = append(violations, fmt.Errorf("%w: layered asset %d has no order", ErrRuleViolation, asset.ID))
+			continue
+		}
+
+		order := *asset.Meta.Order
+		if order < 0 {
+			violations = append(violations, fmt.Errorf("%w: layered asset %d has negative order %d", ErrRuleViolation, asset.ID, order))
+			continue
+		}
+
+		if other, found := orders[order]; found {
+			violations = append(violations, fmt.Errorf("%w: layered assets %d and %d share order %d", ErrRuleViolation, other, asset.ID, order))
+			continue
+		}
+
+		orders[order] = asset.ID
+	}
+
+	// Report per-type limits in asset type order so the result is deterministic
+	assetTypes := make([]types.ItemAssetType, 0, len(counts))
+	for assetType := range counts {
+		assetTypes = append(assetTypes, assetType)
+	}
+
+	slices.Sort(assetTypes)
+
+	for _, assetType := range assetTypes {
+		limit, wearable := v.maxWorn[assetType]
+		if !wearable {
+			violations = append(violations, fmt.Errorf("%w: asset type %s cannot be worn", ErrRuleViolation, assetType))
+			continue
+		}
+
+		if count := counts[assetType]; count > limit {
+			violations = append(violations, fmt.Errorf("%w: %d assets of type %s worn, at most %d allowed",
+				ErrRuleViolation, count, v.typeName[assetType], limit))
+		}
+	}
+
+	return errors.Join(violations...)
+}
+
+// validatePlayerAvatarType checks that the rig type is supported.
+func (v *OutfitValidator) validatePlayerAvatarType(avatarType types.PlayerAvatarType) error {
+	if !slices.Contains(v.rules.PlayerAvatarTypes, avatarType) {
+		return fmt.Errorf("%w: player avatar type %q is not supported", ErrRuleViolation, avatarType)
+	}
+
+	return nil
+}
+
+// checkScale checks that a single scale is within the given bounds.
+// Scales that the rules do not define are not checked.
+func checkScale(name string, value float64, rule types.ScaleRule) error {
+	if rule.Min == 0 && rule.Max == 0 {
+		return nil
+	}
+
+	if value < rule.Min-scaleTolerance || value > rule.Max+scaleTolerance {
+		return fmt.Errorf("%w: %s scale %g is outside [%g, %g]", ErrRuleViolation, name, value, rule.Min, rule.Max)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package avatar_test
+
+import (
+	"testing"
+
+	"github.com/jaxron/roapi.go/pkg/api/resources/avatar"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutfitValidator(t *testing.T) {
+	rules := &types.AvatarRulesResponse{
+		PlayerAvatarTypes: []types.PlayerAvatarType{types.PlayerAvatarTypeR6, types.PlayerAvatarTypeR15},
+		Scales: types.AvatarScaleRules{
+			Height:     types.ScaleRule{Min: 0.9, Max: 1.05, Increment: 0.01},
+			Width:      types.ScaleRule{Min: 0.7, Max: 1, Increment: 0.01},
+			Head:       types.ScaleRule{Min: 0.95, Max: 1, Increment: 0.01},
+			Proportion: types.ScaleRule{Min: 0, Max: 1, Increment: 0.01},
+			BodyType:   types.ScaleRule{Min: 0, Max: 1, Increment: 0.01},
+		},
+		WearableAssetTypes: []types.WearableAssetType{
+			{ID: types.ItemAssetTypeHat, Name: "Hat", MaxNumber: 3},
+			{ID: types.ItemAssetTypeShirt, Name: "Shirt", MaxNumber: 1},
+			{ID: types.ItemAssetTypeJacketAccessory, Name: "JacketAccessory", MaxNumber: 1},
+			{ID: types.ItemAssetTypeSweaterAccessory, Name: "SweaterAccessory", MaxNumber: 1},
+		},
+	}
+	validator := avatar.NewOutfitValidator(rules)
+
+	asset := func(id int64, assetType types.ItemAssetType, order *int32) *types.AssetV2 {
+		a := &types.AssetV2{ID: id, AssetType: types.AssetType{ID: assetType}}
+		if order != nil {
+			a.Meta = &types.AssetMetaV1{Order: order, Version: 1}
+		}
+
+		return a
+	}
+	order := func(o int32) *int32 { return &o }
+
+	t.Run("Valid Outfit", func(t *testing.T) {
+		outfit := &types.OutfitDetailsResponse{
+			PlayerAvatarType: "R15",
+			Scale:            types.ScaleModel{Height: 1, Width: 1, Head: 1, Depth: 1},
+			Assets: []*types.AssetV2{
+				asset(1, types.ItemAssetTypeHat, nil),
+				asset(2, types.ItemAssetTypeHat, nil),
+				asset(3, types.ItemAssetTypeJacketAccessory, order(2)),
+				asset(4, types.ItemAssetTypeSweaterAccessory, order(1)),
+			},
+		}
+		require.NoError(t, validator.ValidateOutfit(outfit))
+	})
+
+	t.Run("Scale Out Of Bounds", func(t *testing.T) {
+		err := validator.ValidateScales(types.ScaleModel{Height: 1.2, Width: 1, Head: 1, Depth: 1})
+		require.ErrorIs(t, err, avatar.ErrRuleViolation)
+		assert.Contains(t, err.Error(), "height")
+	})
+
+	t.Run("Too Many Of One Type", func(t *testing.T) {
+		err := validator.ValidateAssets([]*types.AssetV2{
+			asset(1, types.ItemAssetTypeShirt, nil),
+			asset(2, types.ItemAssetTypeShirt, nil),
+		})
+		require.ErrorIs(t, err, avatar.ErrRuleViolation)
+		assert.Contains(t, err.Error(), "Shirt")
+	})
+
+	t.Run("Asset Type Not Wearable", func(t *testing.T) {
+		err := validator.ValidateAssets([]*types.AssetV2{asset(1, types.ItemAssetTypeDecal, nil)})
+		require.ErrorIs(t, err, avatar.ErrRuleViolation)
+	})
+
+	t.Run("Layered Clothing Order", func(t *testing.T) {
+		err := validator.ValidateAssets([]*types.AssetV2{
+			asset(1, types.ItemAssetTypeJacketAccessory, order(1)),
+			asset(2, types.ItemAssetTypeSweaterAccessory, order(1)),
+		})
+		require.ErrorIs(t, err, avatar.ErrRuleViolation)
+		assert.Contains(t, err.Error(), "share order")
+
+		err = validator.ValidateAssets([]*types.AssetV2{asset(1, types.ItemAssetTypeJacketAccessory, nil)})
+		require.ErrorIs(t, err, avatar.ErrRuleViolation)
+		assert.Contains(t, err.Error(), "no order")
+	})
+
+	t.Run("Unsupported Avatar Type", func(t *testing.T) {
+		err := validator.ValidateAvatar(&types.UserAvatarResponse{
+			PlayerAvatarType: "R9",
+			Scales:           types.ScaleModel{Height: 1, Width: 1, Head: 1},
+		})
+		require.ErrorIs(t, err, avatar.ErrRuleViolation)
+		assert.Contains(t, err.Error(), "R9")
+	})
+}
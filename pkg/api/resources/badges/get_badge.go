@@ -0,0 +1,37 @@
+package badges
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetBadge fetches information about a specific badge.
+// GET https://badges.roblox.com/v1/badges/{badgeId}
+func (r *Resource) GetBadge(ctx context.Context, badgeID int64) (*types.BadgeResponse, error) {
+	if err := r.validate.Var(badgeID, "required,gt=0"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var badge types.BadgeResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/badges/%d", types.BadgesEndpoint, badgeID)).
+		Result(&badge).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&badge); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &badge, nil
+}
@@ -0,0 +1,89 @@
+package badges
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetBadgeAwardedDates fetches the dates a user was awarded the given badges.
+// Badges the user does not own are omitted from the response.
+// GET https://badges.roblox.com/v1/users/{userId}/badges/awarded-dates
+func (r *Resource) GetBadgeAwardedDates(ctx context.Context, p BadgeAwardedDatesParams) (*types.BadgeAwardedDatesResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	// Convert badge IDs to strings and join them
+	ids := make([]string, len(p.BadgeIDs))
+	for i, id := range p.BadgeIDs {
+		ids[i] = strconv.FormatInt(id, 10)
+	}
+
+	var result types.BadgeAwardedDatesResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/users/%d/badges/awarded-dates", types.BadgesEndpoint, p.UserID)).
+		Query("badgeIds", strings.Join(ids, ",")).
+		Result(&result).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&result); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &result, nil
+}
+
+// BadgeAwardedDatesParams holds the parameters for getting badge awarded dates.
+type BadgeAwardedDatesParams struct {
+	UserID   int64   `json:"userId"   validate:"required,gt=0"`
+	BadgeIDs []int64 `json:"badgeIds" validate:"required,min=1,max=100,dive,gt=0"`
+}
+
+// BadgeAwardedDatesBuilder is a builder for BadgeAwardedDatesParams.
+type BadgeAwardedDatesBuilder struct {
+	params BadgeAwardedDatesParams
+}
+
+// NewBadgeAwardedDatesBuilder creates a new BadgeAwardedDatesBuilder.
+func NewBadgeAwardedDatesBuilder(userID int64, badgeIDs ...int64) *BadgeAwardedDatesBuilder {
+	return &BadgeAwardedDatesBuilder{
+		params: BadgeAwardedDatesParams{
+			UserID:   userID,
+			BadgeIDs: badgeIDs,
+		},
+	}
+}
+
+// WithBadgeIDs adds multiple badge IDs to the list.
+func (b *BadgeAwardedDatesBuilder) WithBadgeIDs(badgeIDs ...int64) *BadgeAwardedDatesBuilder {
+	b.params.BadgeIDs = append(b.params.BadgeIDs, badgeIDs...)
+	return b
+}
+
+// RemoveBadgeIDs removes multiple badge IDs from the list.
+func (b *BadgeAwardedDatesBuilder) RemoveBadgeIDs(badgeIDs ...int64) *BadgeAwardedDatesBuilder {
+	b.params.BadgeIDs = slices.DeleteFunc(b.params.BadgeIDs, func(id int64) bool {
+		return slices.Contains(badgeIDs, id)
+	})
+
+	return b
+}
+
+// Build returns the BadgeAwardedDatesParams.
+func (b *BadgeAwardedDatesBuilder) Build() BadgeAwardedDatesParams {
+	return b.params
+}
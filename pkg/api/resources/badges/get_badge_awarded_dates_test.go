@@ -0,0 +1,54 @@
+package badges_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/badges"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBadgeAwardedDates(t *testing.T) {
+	// Create a new test resource
+	api := badges.New(utils.NewTestEnv())
+
+	t.Run("Fetch Awarded Dates For Owned Badges", func(t *testing.T) {
+		userBadges, err := api.GetUserBadges(context.Background(), badges.NewUserBadgesBuilder(utils.SampleUserID5).WithLimit(25).Build())
+		require.NoError(t, err)
+		require.NotEmpty(t, userBadges.Data)
+
+		badgeIDs := make([]int64, 0, len(userBadges.Data))
+		for _, badge := range userBadges.Data {
+			badgeIDs = append(badgeIDs, badge.ID)
+		}
+
+		builder := badges.NewBadgeAwardedDatesBuilder(utils.SampleUserID5, badgeIDs...)
+		result, err := api.GetBadgeAwardedDates(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.Len(t, result.Data, len(badgeIDs))
+
+		for _, awarded := range result.Data {
+			assert.Contains(t, badgeIDs, awarded.BadgeID)
+			assert.NotZero(t, awarded.AwardedDate)
+		}
+	})
+
+	t.Run("Empty Badge IDs", func(t *testing.T) {
+		builder := badges.NewBadgeAwardedDatesBuilder(utils.SampleUserID5)
+		_, err := api.GetBadgeAwardedDates(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "BadgeIDs")
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := badges.NewBadgeAwardedDatesBuilder(utils.SampleUserID5).
+			WithBadgeIDs(1, 2, 3, 4).
+			RemoveBadgeIDs(2, 3)
+
+		params := builder.Build()
+		assert.Equal(t, utils.SampleUserID5, params.UserID)
+		assert.Equal(t, []int64{1, 4}, params.BadgeIDs)
+	})
+}
@@ -0,0 +1,38 @@
+package badges_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/badges"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBadge(t *testing.T) {
+	// Create a new test resource
+	api := badges.New(utils.NewTestEnv())
+
+	// Test case: Fetch a badge found through the user's badge list
+	t.Run("Fetch Known Badge", func(t *testing.T) {
+		userBadges, err := api.GetUserBadges(context.Background(), badges.NewUserBadgesBuilder(utils.SampleUserID5).Build())
+		require.NoError(t, err)
+		require.NotEmpty(t, userBadges.Data)
+
+		badgeID := userBadges.Data[0].ID
+		badge, err := api.GetBadge(context.Background(), badgeID)
+		require.NoError(t, err)
+		assert.NotNil(t, badge)
+		assert.Equal(t, badgeID, badge.ID)
+		assert.NotEmpty(t, badge.Name)
+		assert.NotZero(t, badge.Created)
+	})
+
+	// Test case: Validate with invalid badge ID
+	t.Run("Invalid Badge ID", func(t *testing.T) {
+		badge, err := api.GetBadge(context.Background(), 0)
+		require.Error(t, err)
+		assert.Nil(t, badge)
+	})
+}
@@ -0,0 +1,98 @@
+package badges
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetUniverseBadges fetches the paginated list of badges for a universe.
+// GET https://badges.roblox.com/v1/universes/{universeId}/badges
+func (r *Resource) GetUniverseBadges(ctx context.Context, p UniverseBadgesParams) (*types.BadgePageResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var badges types.BadgePageResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/universes/%d/badges", types.BadgesEndpoint, p.UniverseID)).
+		Query("sortBy", string(p.SortBy)).
+		Query("limit", strconv.FormatInt(p.Limit, 10)).
+		Query("cursor", p.Cursor).
+		Query("sortOrder", string(p.SortOrder)).
+		Result(&badges).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&badges); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &badges, nil
+}
+
+// UniverseBadgesParams holds the parameters for getting universe badges.
+type UniverseBadgesParams struct {
+	UniverseID int64             `json:"universeId" validate:"required,gt=0"`
+	SortBy     types.BadgeSortBy `json:"sortBy"     validate:"omitempty,oneof=Rank DateCreated"`
+	Limit      int64             `json:"limit"      validate:"oneof=10 25 50 100"`
+	Cursor     string            `json:"cursor"     validate:"omitempty"`
+	SortOrder  types.SortOrder   `json:"sortOrder"  validate:"omitempty,oneof=Asc Desc"`
+}
+
+// UniverseBadgesBuilder is a builder for UniverseBadgesParams.
+type UniverseBadgesBuilder struct {
+	params UniverseBadgesParams
+}
+
+// NewUniverseBadgesBuilder creates a new UniverseBadgesBuilder with default values.
+func NewUniverseBadgesBuilder(universeID int64) *UniverseBadgesBuilder {
+	return &UniverseBadgesBuilder{
+		params: UniverseBadgesParams{
+			UniverseID: universeID,
+			SortBy:     types.BadgeSortByRank,
+			Limit:      10,
+			Cursor:     "",
+			SortOrder:  types.SortOrderAsc,
+		},
+	}
+}
+
+// WithSortBy sets the field to sort by.
+func (b *UniverseBadgesBuilder) WithSortBy(sortBy types.BadgeSortBy) *UniverseBadgesBuilder {
+	b.params.SortBy = sortBy
+	return b
+}
+
+// WithLimit sets the limit.
+func (b *UniverseBadgesBuilder) WithLimit(limit int64) *UniverseBadgesBuilder {
+	b.params.Limit = limit
+	return b
+}
+
+// WithCursor sets the cursor.
+func (b *UniverseBadgesBuilder) WithCursor(cursor string) *UniverseBadgesBuilder {
+	b.params.Cursor = cursor
+	return b
+}
+
+// WithSortOrder sets the sort order.
+func (b *UniverseBadgesBuilder) WithSortOrder(order types.SortOrder) *UniverseBadgesBuilder {
+	b.params.SortOrder = order
+	return b
+}
+
+// Build returns the UniverseBadgesParams.
+func (b *UniverseBadgesBuilder) Build() UniverseBadgesParams {
+	return b.params
+}
@@ -0,0 +1,58 @@
+package badges_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/badges"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUniverseBadges(t *testing.T) {
+	// Create a new test resource
+	api := badges.New(utils.NewTestEnv())
+
+	t.Run("Fetch Universe Badges Successfully", func(t *testing.T) {
+		builder := badges.NewUniverseBadgesBuilder(utils.SampleUniverseID)
+		result, err := api.GetUniverseBadges(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.Data)
+
+		for _, badge := range result.Data {
+			assert.NotZero(t, badge.ID)
+			assert.NotEmpty(t, badge.Name)
+		}
+	})
+
+	t.Run("Fetch With Invalid Universe ID", func(t *testing.T) {
+		builder := badges.NewUniverseBadgesBuilder(utils.InvalidUniverseID)
+		_, err := api.GetUniverseBadges(context.Background(), builder.Build())
+		require.Error(t, err)
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := badges.NewUniverseBadgesBuilder(utils.SampleUniverseID).
+			WithSortBy(types.BadgeSortByDateCreated).
+			WithLimit(25).
+			WithCursor("nextPageCursor").
+			WithSortOrder(types.SortOrderDesc)
+
+		params := builder.Build()
+		assert.Equal(t, utils.SampleUniverseID, params.UniverseID)
+		assert.Equal(t, types.BadgeSortByDateCreated, params.SortBy)
+		assert.Equal(t, int64(25), params.Limit)
+		assert.Equal(t, "nextPageCursor", params.Cursor)
+		assert.Equal(t, types.SortOrderDesc, params.SortOrder)
+	})
+
+	t.Run("Invalid Limit", func(t *testing.T) {
+		builder := badges.NewUniverseBadgesBuilder(utils.SampleUniverseID).WithLimit(30)
+		_, err := api.GetUniverseBadges(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Limit")
+	})
+}
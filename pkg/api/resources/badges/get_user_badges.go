@@ -0,0 +1,89 @@
+package badges
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetUserBadges fetches the paginated list of badges awarded to a user.
+// GET https://badges.roblox.com/v1/users/{userId}/badges
+func (r *Resource) GetUserBadges(ctx context.Context, p UserBadgesParams) (*types.BadgePageResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var badges types.BadgePageResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/users/%d/badges", types.BadgesEndpoint, p.UserID)).
+		Query("limit", strconv.FormatInt(p.Limit, 10)).
+		Query("cursor", p.Cursor).
+		Query("sortOrder", string(p.SortOrder)).
+		Result(&badges).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&badges); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &badges, nil
+}
+
+// UserBadgesParams holds the parameters for getting user badges.
+type UserBadgesParams struct {
+	UserID    int64           `json:"userId"    validate:"required,gt=0"`
+	Limit     int64           `json:"limit"     validate:"oneof=10 25 50 100"`
+	Cursor    string          `json:"cursor"    validate:"omitempty"`
+	SortOrder types.SortOrder `json:"sortOrder" validate:"omitempty,oneof=Asc Desc"`
+}
+
+// UserBadgesBuilder is a builder for UserBadgesParams.
+type UserBadgesBuilder struct {
+	params UserBadgesParams
+}
+
+// NewUserBadgesBuilder creates a new UserBadgesBuilder with default values.
+func NewUserBadgesBuilder(userID int64) *UserBadgesBuilder {
+	return &UserBadgesBuilder{
+		params: UserBadgesParams{
+			UserID:    userID,
+			Limit:     10,
+			Cursor:    "",
+			SortOrder: types.SortOrderDesc,
+		},
+	}
+}
+
+// WithLimit sets the limit.
+func (b *UserBadgesBuilder) WithLimit(limit int64) *UserBadgesBuilder {
+	b.params.Limit = limit
+	return b
+}
+
+// WithCursor sets the cursor.
+func (b *UserBadgesBuilder) WithCursor(cursor string) *UserBadgesBuilder {
+	b.params.Cursor = cursor
+	return b
+}
+
+// WithSortOrder sets the sort order.
+func (b *UserBadgesBuilder) WithSortOrder(order types.SortOrder) *UserBadgesBuilder {
+	b.params.SortOrder = order
+	return b
+}
+
+// Build returns the UserBadgesParams.
+func (b *UserBadgesBuilder) Build() UserBadgesParams {
+	return b.params
+}
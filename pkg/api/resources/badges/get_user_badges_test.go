@@ -0,0 +1,62 @@
+package badges_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/badges"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserBadges(t *testing.T) {
+	// Create a new test resource
+	api := badges.New(utils.NewTestEnv())
+
+	t.Run("Fetch User Badges Successfully", func(t *testing.T) {
+		builder := badges.NewUserBadgesBuilder(utils.SampleUserID5).WithLimit(25)
+		result, err := api.GetUserBadges(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotEmpty(t, result.Data)
+
+		for _, badge := range result.Data {
+			assert.NotZero(t, badge.ID)
+			assert.NotEmpty(t, badge.Name)
+		}
+	})
+
+	t.Run("Fetch With Invalid User ID", func(t *testing.T) {
+		builder := badges.NewUserBadgesBuilder(utils.InvalidUserID)
+		_, err := api.GetUserBadges(context.Background(), builder.Build())
+		require.Error(t, err)
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := badges.NewUserBadgesBuilder(utils.SampleUserID5).
+			WithLimit(50).
+			WithCursor("nextPageCursor").
+			WithSortOrder(types.SortOrderAsc)
+
+		params := builder.Build()
+		assert.Equal(t, utils.SampleUserID5, params.UserID)
+		assert.Equal(t, int64(50), params.Limit)
+		assert.Equal(t, "nextPageCursor", params.Cursor)
+		assert.Equal(t, types.SortOrderAsc, params.SortOrder)
+	})
+
+	t.Run("Test Pagination", func(t *testing.T) {
+		builder := badges.NewUserBadgesBuilder(utils.SampleUserID5)
+		result, err := api.GetUserBadges(context.Background(), builder.Build())
+		require.NoError(t, err)
+
+		if result.NextPageCursor != nil {
+			builder.WithCursor(*result.NextPageCursor)
+			nextPage, err := api.GetUserBadges(context.Background(), builder.Build())
+			require.NoError(t, err)
+			assert.NotEmpty(t, nextPage.Data)
+		}
+	})
+}
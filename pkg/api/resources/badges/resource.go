@@ -0,0 +1,34 @@
+package badges
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ResourceInterface defines the interface for badge-related operations.
+type ResourceInterface interface {
+	GetBadge(ctx context.Context, badgeID int64) (*types.BadgeResponse, error)
+	GetUniverseBadges(ctx context.Context, p UniverseBadgesParams) (*types.BadgePageResponse, error)
+	GetUserBadges(ctx context.Context, p UserBadgesParams) (*types.BadgePageResponse, error)
+	GetBadgeAwardedDates(ctx context.Context, p BadgeAwardedDatesParams) (*types.BadgeAwardedDatesResponse, error)
+}
+
+// Ensure Resource implements the ResourceInterface.
+var _ ResourceInterface = (*Resource)(nil)
+
+// Resource provides methods for interacting with badge-related endpoints.
+type Resource struct {
+	client   *client.Client
+	validate *validator.Validate
+}
+
+// New creates a new Resource with the specified client and validator.
+func New(client *client.Client, validate *validator.Validate) *Resource {
+	return &Resource{
+		client:   client,
+		validate: validate,
+	}
+}
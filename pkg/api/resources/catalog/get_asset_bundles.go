@@ -0,0 +1,89 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetAssetBundles fetches the paginated list of bundles that contain the given asset.
+// GET https://catalog.roblox.com/v1/assets/{assetId}/bundles
+func (r *Resource) GetAssetBundles(ctx context.Context, p AssetBundlesParams) (*types.BundlePageResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var result types.BundlePageResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/assets/%d/bundles", types.CatalogEndpoint, p.AssetID)).
+		Query("limit", strconv.FormatInt(p.Limit, 10)).
+		Query("cursor", p.Cursor).
+		Query("sortOrder", string(p.SortOrder)).
+		Result(&result).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&result); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &result, nil
+}
+
+// AssetBundlesParams holds the parameters for getting the bundles containing an asset.
+type AssetBundlesParams struct {
+	AssetID   int64           `json:"assetId"   validate:"required,gt=0"`
+	Limit     int64           `json:"limit"     validate:"oneof=10 25 50 100"`
+	Cursor    string          `json:"cursor"    validate:"omitempty"`
+	SortOrder types.SortOrder `json:"sortOrder" validate:"omitempty,oneof=Asc Desc"`
+}
+
+// AssetBundlesBuilder is a builder for AssetBundlesParams.
+type AssetBundlesBuilder struct {
+	params AssetBundlesParams
+}
+
+// NewAssetBundlesBuilder creates a new AssetBundlesBuilder with default values.
+func NewAssetBundlesBuilder(assetID int64) *AssetBundlesBuilder {
+	return &AssetBundlesBuilder{
+		params: AssetBundlesParams{
+			AssetID:   assetID,
+			Limit:     10,
+			Cursor:    "",
+			SortOrder: types.SortOrderAsc,
+		},
+	}
+}
+
+// WithLimit sets the limit.
+func (b *AssetBundlesBuilder) WithLimit(limit int64) *AssetBundlesBuilder {
+	b.params.Limit = limit
+	return b
+}
+
+// WithCursor sets the cursor.
+func (b *AssetBundlesBuilder) WithCursor(cursor string) *AssetBundlesBuilder {
+	b.params.Cursor = cursor
+	return b
+}
+
+// WithSortOrder sets the sort order.
+func (b *AssetBundlesBuilder) WithSortOrder(order types.SortOrder) *AssetBundlesBuilder {
+	b.params.SortOrder = order
+	return b
+}
+
+// Build returns the AssetBundlesParams.
+func (b *AssetBundlesBuilder) Build() AssetBundlesParams {
+	return b.params
+}
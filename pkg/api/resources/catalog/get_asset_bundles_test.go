@@ -0,0 +1,57 @@
+package catalog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/catalog"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAssetBundles(t *testing.T) {
+	// Create a new test resource
+	api := catalog.New(utils.NewTestEnv())
+
+	t.Run("Fetch Bundles Containing Asset", func(t *testing.T) {
+		bundles, err := api.GetBundleDetails(context.Background(), []int64{utils.SampleBundleID})
+		require.NoError(t, err)
+		require.NotEmpty(t, bundles)
+		require.NotEmpty(t, bundles[0].Items)
+
+		builder := catalog.NewAssetBundlesBuilder(bundles[0].Items[0].ID)
+		result, err := api.GetAssetBundles(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.Data)
+
+		found := false
+		for _, bundle := range result.Data {
+			if bundle.ID == utils.SampleBundleID {
+				found = true
+			}
+		}
+
+		assert.True(t, found, "asset should belong to the bundle it was taken from")
+	})
+
+	t.Run("Invalid Asset ID", func(t *testing.T) {
+		builder := catalog.NewAssetBundlesBuilder(utils.InvalidAssetID)
+		_, err := api.GetAssetBundles(context.Background(), builder.Build())
+		require.Error(t, err)
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := catalog.NewAssetBundlesBuilder(utils.SampleAssetID).
+			WithLimit(25).
+			WithCursor("nextPageCursor").
+			WithSortOrder(types.SortOrderDesc)
+
+		params := builder.Build()
+		assert.Equal(t, utils.SampleAssetID, params.AssetID)
+		assert.Equal(t, int64(25), params.Limit)
+		assert.Equal(t, "nextPageCursor", params.Cursor)
+		assert.Equal(t, types.SortOrderDesc, params.SortOrder)
+	})
+}
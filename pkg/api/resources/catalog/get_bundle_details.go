@@ -0,0 +1,46 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetBundleDetails fetches details for multiple bundles simultaneously.
+// GET https://catalog.roblox.com/v1/bundles/details?bundleIds={bundleIds}
+func (r *Resource) GetBundleDetails(ctx context.Context, bundleIDs []int64) ([]*types.BundleDetails, error) {
+	if err := r.validate.Var(bundleIDs, "required,min=1,max=100,dive,gt=0"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	// Convert bundle IDs to strings and join them
+	ids := make([]string, len(bundleIDs))
+	for i, id := range bundleIDs {
+		ids[i] = strconv.FormatInt(id, 10)
+	}
+
+	var result []*types.BundleDetails
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(types.CatalogEndpoint+"/v1/bundles/details").
+		Query("bundleIds", strings.Join(ids, ",")).
+		Result(&result).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Var(result, "required,dive"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,39 @@
+package catalog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/catalog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBundleDetails(t *testing.T) {
+	// Create a new test resource
+	api := catalog.New(utils.NewTestEnv())
+
+	t.Run("Fetch Known Bundle Details", func(t *testing.T) {
+		result, err := api.GetBundleDetails(context.Background(), []int64{utils.SampleBundleID, utils.SampleBundleID2})
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+
+		for _, bundle := range result {
+			assert.NotZero(t, bundle.ID)
+			assert.NotEmpty(t, bundle.Name)
+			assert.True(t, bundle.BundleType.IsABundleType())
+			assert.NotEmpty(t, bundle.Items)
+		}
+	})
+
+	t.Run("Invalid Bundle ID", func(t *testing.T) {
+		_, err := api.GetBundleDetails(context.Background(), []int64{utils.InvalidBundleID})
+		require.Error(t, err)
+	})
+
+	t.Run("Empty Bundle IDs", func(t *testing.T) {
+		_, err := api.GetBundleDetails(context.Background(), []int64{})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,81 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetAssetRecommendations fetches assets recommended alongside the given asset.
+// GET https://catalog.roblox.com/v1/recommendations/asset/{assetTypeId}
+func (r *Resource) GetAssetRecommendations(ctx context.Context, p AssetRecommendationsParams) (*types.AssetRecommendationsResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var result types.AssetRecommendationsResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/recommendations/asset/%d", types.CatalogEndpoint, p.AssetType)).
+		Query("contextAssetId", strconv.FormatInt(p.AssetID, 10)).
+		Query("numItems", strconv.FormatInt(p.NumItems, 10)).
+		Result(&result).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&result); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &result, nil
+}
+
+// GetBundleRecommendations fetches bundles recommended alongside the given bundle.
+// GET https://catalog.roblox.com/v1/bundles/{bundleId}/recommendations
+func (r *Resource) GetBundleRecommendations(ctx context.Context, p BundleRecommendationsParams) (*types.BundleRecommendationsResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var result types.BundleRecommendationsResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/bundles/%d/recommendations", types.CatalogEndpoint, p.BundleID)).
+		Query("numItems", strconv.FormatInt(p.NumItems, 10)).
+		Result(&result).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&result); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &result, nil
+}
+
+// AssetRecommendationsParams holds the parameters for getting asset recommendations.
+type AssetRecommendationsParams struct {
+	AssetID   int64               `json:"contextAssetId" validate:"required,gt=0"`
+	AssetType types.ItemAssetType `json:"assetTypeId"    validate:"required,gt=0"`
+	NumItems  int64               `json:"numItems"       validate:"required,min=1,max=50"`
+}
+
+// BundleRecommendationsParams holds the parameters for getting bundle recommendations.
+type BundleRecommendationsParams struct {
+	BundleID int64 `json:"bundleId" validate:"required,gt=0"`
+	NumItems int64 `json:"numItems" validate:"required,min=1,max=50"`
+}
@@ -0,0 +1,64 @@
+package catalog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/catalog"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRecommendations(t *testing.T) {
+	// Create a new test resource
+	api := catalog.New(utils.NewTestEnv())
+
+	t.Run("Fetch Asset Recommendations", func(t *testing.T) {
+		result, err := api.GetAssetRecommendations(context.Background(), catalog.AssetRecommendationsParams{
+			AssetID:   utils.SampleLimitedAssetID,
+			AssetType: types.ItemAssetTypeHat,
+			NumItems:  10,
+		})
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+
+		for _, recommendation := range result.Data {
+			assert.NotZero(t, recommendation.Item.AssetID)
+			assert.NotEmpty(t, recommendation.Item.Name)
+		}
+	})
+
+	t.Run("Fetch Bundle Recommendations", func(t *testing.T) {
+		result, err := api.GetBundleRecommendations(context.Background(), catalog.BundleRecommendationsParams{
+			BundleID: utils.SampleBundleID,
+			NumItems: 10,
+		})
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+
+		for _, bundle := range result.Data {
+			assert.NotZero(t, bundle.ID)
+			assert.True(t, bundle.BundleType.IsABundleType())
+		}
+	})
+
+	t.Run("Invalid Number Of Items", func(t *testing.T) {
+		_, err := api.GetBundleRecommendations(context.Background(), catalog.BundleRecommendationsParams{
+			BundleID: utils.SampleBundleID,
+			NumItems: 0,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "NumItems")
+	})
+
+	t.Run("Missing Asset Type", func(t *testing.T) {
+		_, err := api.GetAssetRecommendations(context.Background(), catalog.AssetRecommendationsParams{
+			AssetID:  utils.SampleAssetID,
+			NumItems: 10,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "AssetType")
+	})
+}
@@ -0,0 +1,107 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetUserBundles fetches the paginated list of bundles owned by a user.
+// If a bundle type is set, only bundles of that type are returned.
+// GET https://catalog.roblox.com/v1/users/{userId}/bundles
+// GET https://catalog.roblox.com/v1/users/{userId}/bundles/{bundleType}
+func (r *Resource) GetUserBundles(ctx context.Context, p UserBundlesParams) (*types.BundlePageResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+
+	url := fmt.Sprintf("%s/v1/users/%d/bundles", types.CatalogEndpoint, p.UserID)
+	if p.BundleType != 0 {
+		url = fmt.Sprintf("%s/%d", url, p.BundleType)
+	}
+
+	var result types.BundlePageResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(url).
+		Query("limit", strconv.FormatInt(p.Limit, 10)).
+		Query("cursor", p.Cursor).
+		Query("sortOrder", string(p.SortOrder)).
+		Result(&result).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&result); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &result, nil
+}
+
+// UserBundlesParams holds the parameters for getting a user's owned bundles.
+type UserBundlesParams struct {
+	UserID     int64            `json:"userId"     validate:"required,gt=0"`
+	BundleType types.BundleType `json:"bundleType" validate:"omitempty,min=1"`
+	Limit      int64            `json:"limit"      validate:"oneof=10 25 50 100"`
+	Cursor     string           `json:"cursor"     validate:"omitempty"`
+	SortOrder  types.SortOrder  `json:"sortOrder"  validate:"omitempty,oneof=Asc Desc"`
+}
+
+// UserBundlesBuilder is a builder for UserBundlesParams.
+type UserBundlesBuilder struct {
+	params UserBundlesParams
+}
+
+// NewUserBundlesBuilder creates a new UserBundlesBuilder with default values.
+func NewUserBundlesBuilder(userID int64) *UserBundlesBuilder {
+	return &UserBundlesBuilder{
+		params: UserBundlesParams{
+			UserID:     userID,
+			BundleType: 0,
+			Limit:      10,
+			Cursor:     "",
+			SortOrder:  types.SortOrderAsc,
+		},
+	}
+}
+
+// WithBundleType filters the results to bundles of the given type.
+func (b *UserBundlesBuilder) WithBundleType(bundleType types.BundleType) *UserBundlesBuilder {
+	b.params.BundleType = bundleType
+	return b
+}
+
+// WithLimit sets the limit.
+func (b *UserBundlesBuilder) WithLimit(limit int64) *UserBundlesBuilder {
+	b.params.Limit = limit
+	return b
+}
+
+// WithCursor sets the cursor.
+func (b *UserBundlesBuilder) WithCursor(cursor string) *UserBundlesBuilder {
+	b.params.Cursor = cursor
+	return b
+}
+
+// WithSortOrder sets the sort order.
+func (b *UserBundlesBuilder) WithSortOrder(order types.SortOrder) *UserBundlesBuilder {
+	b.params.SortOrder = order
+	return b
+}
+
+// Build returns the UserBundlesParams.
+func (b *UserBundlesBuilder) Build() UserBundlesParams {
+	return b.params
+}
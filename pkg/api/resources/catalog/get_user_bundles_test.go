@@ -0,0 +1,61 @@
+package catalog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/catalog"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserBundles(t *testing.T) {
+	// Create a new test resource
+	api := catalog.New(utils.NewTestEnv())
+
+	t.Run("Fetch User Bundles", func(t *testing.T) {
+		builder := catalog.NewUserBundlesBuilder(utils.SampleUserID5).WithLimit(25)
+		result, err := api.GetUserBundles(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+
+		for _, bundle := range result.Data {
+			assert.NotZero(t, bundle.ID)
+			assert.NotEmpty(t, bundle.Name)
+		}
+	})
+
+	t.Run("Fetch User Bundles By Type", func(t *testing.T) {
+		builder := catalog.NewUserBundlesBuilder(utils.SampleUserID5).
+			WithBundleType(types.BundleTypeBodyParts)
+		result, err := api.GetUserBundles(context.Background(), builder.Build())
+		require.NoError(t, err)
+
+		for _, bundle := range result.Data {
+			assert.Equal(t, types.BundleTypeBodyParts, bundle.BundleType)
+		}
+	})
+
+	t.Run("Invalid User ID", func(t *testing.T) {
+		builder := catalog.NewUserBundlesBuilder(utils.InvalidUserID)
+		_, err := api.GetUserBundles(context.Background(), builder.Build())
+		require.Error(t, err)
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := catalog.NewUserBundlesBuilder(utils.SampleUserID1).
+			WithBundleType(types.BundleTypeAvatarAnimations).
+			WithLimit(50).
+			WithCursor("nextPageCursor").
+			WithSortOrder(types.SortOrderDesc)
+
+		params := builder.Build()
+		assert.Equal(t, utils.SampleUserID1, params.UserID)
+		assert.Equal(t, types.BundleTypeAvatarAnimations, params.BundleType)
+		assert.Equal(t, int64(50), params.Limit)
+		assert.Equal(t, "nextPageCursor", params.Cursor)
+		assert.Equal(t, types.SortOrderDesc, params.SortOrder)
+	})
+}
@@ -2,6 +2,7 @@ package catalog
 
 import (
 	"context"
+	"iter"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/jaxron/axonet/pkg/client"
@@ -11,6 +12,13 @@ import (
 // ResourceInterface defines the interface for catalog-related operations.
 type ResourceInterface interface {
 	GetItemDetails(ctx context.Context, params GetItemDetailsParams) (*types.ItemDetailsResponse, error)
+	GetBundleDetails(ctx context.Context, bundleIDs []int64) ([]*types.BundleDetails, error)
+	GetAssetBundles(ctx context.Context, params AssetBundlesParams) (*types.BundlePageResponse, error)
+	GetUserBundles(ctx context.Context, params UserBundlesParams) (*types.BundlePageResponse, error)
+	GetAssetRecommendations(ctx context.Context, params AssetRecommendationsParams) (*types.AssetRecommendationsResponse, error)
+	GetBundleRecommendations(ctx context.Context, params BundleRecommendationsParams) (*types.BundleRecommendationsResponse, error)
+	SearchItems(ctx context.Context, params SearchItemsParams) (*types.CatalogSearchResponse, error)
+	SearchItemsAll(ctx context.Context, params SearchItemsParams) iter.Seq2[*types.CatalogItem, error]
 }
 
 // Ensure Resource implements the ResourceInterface.
@@ -0,0 +1,254 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// SearchItems searches the catalog using the given filters.
+// GET https://catalog.roblox.com/v2/search/items/details
+func (r *Resource) SearchItems(ctx context.Context, p SearchItemsParams) (*types.CatalogSearchResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var result types.CatalogSearchResponse
+
+	req := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(types.CatalogEndpoint+"/v2/search/items/details").
+		Query("limit", strconv.FormatInt(p.Limit, 10)).
+		Query("includeNotForSale", strconv.FormatBool(p.IncludeNotForSale)).
+		Result(&result)
+
+	// Only send the filters that were set so the API applies its own defaults
+	if p.Category != "" {
+		req.Query("category", string(p.Category))
+	}
+
+	if p.Subcategory != "" {
+		req.Query("subcategory", string(p.Subcategory))
+	}
+
+	if p.Keyword != "" {
+		req.Query("keyword", p.Keyword)
+	}
+
+	if p.CreatorName != "" {
+		req.Query("creatorName", p.CreatorName)
+	}
+
+	if p.CreatorTargetID != 0 {
+		req.Query("creatorTargetId", strconv.FormatInt(p.CreatorTargetID, 10))
+	}
+
+	if p.CreatorType != "" {
+		req.Query("creatorType", string(p.CreatorType))
+	}
+
+	if p.MinPrice != nil {
+		req.Query("minPrice", strconv.FormatInt(*p.MinPrice, 10))
+	}
+
+	if p.MaxPrice != nil {
+		req.Query("maxPrice", strconv.FormatInt(*p.MaxPrice, 10))
+	}
+
+	if p.SalesType != 0 {
+		req.Query("salesTypeFilter", strconv.FormatInt(int64(p.SalesType), 10))
+	}
+
+	if p.SortType != nil {
+		req.Query("sortType", strconv.FormatInt(int64(*p.SortType), 10))
+	}
+
+	if p.SortAggregation != 0 {
+		req.Query("sortAggregation", strconv.FormatInt(int64(p.SortAggregation), 10))
+	}
+
+	if p.Cursor != "" {
+		req.Query("cursor", p.Cursor)
+	}
+
+	resp, err := req.Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&result); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &result, nil
+}
+
+// SearchItemsAll returns an iterator over every catalog item matching the given filters,
+// following the next page cursor until the results are exhausted.
+// Iteration stops at the first error, which is yielded with a nil item.
+func (r *Resource) SearchItemsAll(ctx context.Context, p SearchItemsParams) iter.Seq2[*types.CatalogItem, error] {
+	return func(yield func(*types.CatalogItem, error) bool) {
+		for {
+			result, err := r.SearchItems(ctx, p)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, item := range result.Data {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if result.NextPageCursor == nil || *result.NextPageCursor == "" {
+				return
+			}
+
+			p.Cursor = *result.NextPageCursor
+		}
+	}
+}
+
+// SearchItemsParams holds the parameters for searching the catalog.
+type SearchItemsParams struct {
+	Category          types.CatalogCategory        `json:"category"          validate:"omitempty"`
+	Subcategory       types.CatalogSubcategory     `json:"subcategory"       validate:"omitempty"`
+	Keyword           string                       `json:"keyword"           validate:"omitempty,max=50"`
+	CreatorName       string                       `json:"creatorName"       validate:"omitempty"`
+	CreatorTargetID   int64                        `json:"creatorTargetId"   validate:"omitempty,gt=0"`
+	CreatorType       types.CatalogCreatorType     `json:"creatorType"       validate:"omitempty,oneof=User Group"`
+	MinPrice          *int64                       `json:"minPrice"          validate:"omitempty,gte=0"`
+	MaxPrice          *int64                       `json:"maxPrice"          validate:"omitempty,gte=0,gtefield=MinPrice|excluded_with=MinPrice"`
+	SalesType         types.CatalogSalesType       `json:"salesTypeFilter"   validate:"omitempty,oneof=1 2 3"`
+	SortType          *types.CatalogSortType       `json:"sortType"          validate:"omitempty,min=0,max=5"`
+	SortAggregation   types.CatalogSortAggregation `json:"sortAggregation"   validate:"omitempty,oneof=1 3 5"`
+	IncludeNotForSale bool                         `json:"includeNotForSale"`
+	Limit             int64                        `json:"limit"             validate:"oneof=10 28 30 60 120"`
+	Cursor            string                       `json:"cursor"            validate:"omitempty"`
+}
+
+// SearchItemsBuilder is a builder for SearchItemsParams.
+type SearchItemsBuilder struct {
+	params SearchItemsParams
+}
+
+// NewSearchItemsBuilder creates a new SearchItemsBuilder with default values.
+func NewSearchItemsBuilder() *SearchItemsBuilder {
+	return &SearchItemsBuilder{
+		params: SearchItemsParams{
+			Category:          "",
+			Subcategory:       "",
+			Keyword:           "",
+			CreatorName:       "",
+			CreatorTargetID:   0,
+			CreatorType:       "",
+			MinPrice:          nil,
+			MaxPrice:          nil,
+			SalesType:         0,
+			SortType:          nil,
+			SortAggregation:   0,
+			IncludeNotForSale: false,
+			Limit:             30,
+			Cursor:            "",
+		},
+	}
+}
+
+// WithCategory sets the category.
+func (b *SearchItemsBuilder) WithCategory(category types.CatalogCategory) *SearchItemsBuilder {
+	b.params.Category = category
+	return b
+}
+
+// WithSubcategory sets the subcategory.
+func (b *SearchItemsBuilder) WithSubcategory(subcategory types.CatalogSubcategory) *SearchItemsBuilder {
+	b.params.Subcategory = subcategory
+	return b
+}
+
+// WithKeyword sets the search keyword.
+func (b *SearchItemsBuilder) WithKeyword(keyword string) *SearchItemsBuilder {
+	b.params.Keyword = keyword
+	return b
+}
+
+// WithCreatorName filters results to items created by the given user or group name.
+func (b *SearchItemsBuilder) WithCreatorName(name string) *SearchItemsBuilder {
+	b.params.CreatorName = name
+	return b
+}
+
+// WithCreator filters results to items created by the given user or group ID.
+func (b *SearchItemsBuilder) WithCreator(creatorType types.CatalogCreatorType, creatorTargetID int64) *SearchItemsBuilder {
+	b.params.CreatorType = creatorType
+	b.params.CreatorTargetID = creatorTargetID
+	return b
+}
+
+// WithPriceRange filters results to items priced between minPrice and maxPrice inclusive.
+func (b *SearchItemsBuilder) WithPriceRange(minPrice, maxPrice int64) *SearchItemsBuilder {
+	b.params.MinPrice = &minPrice
+	b.params.MaxPrice = &maxPrice
+	return b
+}
+
+// WithMinPrice sets the minimum price.
+func (b *SearchItemsBuilder) WithMinPrice(minPrice int64) *SearchItemsBuilder {
+	b.params.MinPrice = &minPrice
+	return b
+}
+
+// WithMaxPrice sets the maximum price.
+func (b *SearchItemsBuilder) WithMaxPrice(maxPrice int64) *SearchItemsBuilder {
+	b.params.MaxPrice = &maxPrice
+	return b
+}
+
+// WithSalesType sets the sales type filter.
+func (b *SearchItemsBuilder) WithSalesType(salesType types.CatalogSalesType) *SearchItemsBuilder {
+	b.params.SalesType = salesType
+	return b
+}
+
+// WithSortType sets the sort type.
+func (b *SearchItemsBuilder) WithSortType(sortType types.CatalogSortType) *SearchItemsBuilder {
+	b.params.SortType = &sortType
+	return b
+}
+
+// WithSortAggregation sets the time window used by popularity-based sort types.
+func (b *SearchItemsBuilder) WithSortAggregation(aggregation types.CatalogSortAggregation) *SearchItemsBuilder {
+	b.params.SortAggregation = aggregation
+	return b
+}
+
+// WithIncludeNotForSale sets whether off-sale items are included.
+func (b *SearchItemsBuilder) WithIncludeNotForSale(include bool) *SearchItemsBuilder {
+	b.params.IncludeNotForSale = include
+	return b
+}
+
+// WithLimit sets the page size.
+func (b *SearchItemsBuilder) WithLimit(limit int64) *SearchItemsBuilder {
+	b.params.Limit = limit
+	return b
+}
+
+// WithCursor sets the cursor.
+func (b *SearchItemsBuilder) WithCursor(cursor string) *SearchItemsBuilder {
+	b.params.Cursor = cursor
+	return b
+}
+
+// Build returns the SearchItemsParams.
+func (b *SearchItemsBuilder) Build() SearchItemsParams {
+	return b.params
+}
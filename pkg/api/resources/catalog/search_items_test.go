@@ -0,0 +1,119 @@
+package catalog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/resources/catalog"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchItems(t *testing.T) {
+	// Create a new test resource
+	api := catalog.New(utils.NewTestEnv())
+
+	t.Run("Search By Keyword", func(t *testing.T) {
+		builder := catalog.NewSearchItemsBuilder().
+			WithCategory(types.CatalogCategoryAccessories).
+			WithKeyword("hat").
+			WithLimit(10)
+		result, err := api.SearchItems(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotEmpty(t, result.Data)
+
+		for _, item := range result.Data {
+			assert.NotZero(t, item.ID)
+			assert.NotEmpty(t, item.Name)
+		}
+	})
+
+	t.Run("Search By Creator", func(t *testing.T) {
+		builder := catalog.NewSearchItemsBuilder().
+			WithCreator(types.CatalogCreatorTypeUser, utils.SampleUserID4).
+			WithSortType(types.CatalogSortTypeMostFavorited).
+			WithSortAggregation(types.CatalogSortAggregationAllTime).
+			WithIncludeNotForSale(true).
+			WithLimit(10)
+		result, err := api.SearchItems(context.Background(), builder.Build())
+		require.NoError(t, err)
+
+		for _, item := range result.Data {
+			assert.Equal(t, utils.SampleUserID4, item.CreatorTargetID)
+		}
+	})
+
+	t.Run("Iterate Pages", func(t *testing.T) {
+		builder := catalog.NewSearchItemsBuilder().
+			WithCategory(types.CatalogCategoryClothing).
+			WithLimit(10)
+
+		count := 0
+		for item, err := range api.SearchItemsAll(context.Background(), builder.Build()) {
+			require.NoError(t, err)
+			assert.NotZero(t, item.ID)
+
+			count++
+			if count == 25 {
+				break
+			}
+		}
+
+		assert.Equal(t, 25, count)
+	})
+
+	t.Run("Invalid Limit", func(t *testing.T) {
+		builder := catalog.NewSearchItemsBuilder().WithLimit(15)
+		_, err := api.SearchItems(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Limit")
+	})
+
+	t.Run("Invalid Sales Type", func(t *testing.T) {
+		builder := catalog.NewSearchItemsBuilder().WithSalesType(9)
+		_, err := api.SearchItems(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "SalesType")
+	})
+
+	t.Run("Invalid Price Range", func(t *testing.T) {
+		builder := catalog.NewSearchItemsBuilder().WithPriceRange(100, 10)
+		_, err := api.SearchItems(context.Background(), builder.Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "MaxPrice")
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := catalog.NewSearchItemsBuilder().
+			WithCategory(types.CatalogCategoryAccessories).
+			WithSubcategory(types.CatalogSubcategoryHats).
+			WithKeyword("crown").
+			WithCreatorName("Roblox").
+			WithPriceRange(10, 500).
+			WithSalesType(types.CatalogSalesTypeCollectibles).
+			WithSortType(types.CatalogSortTypePriceLowToHigh).
+			WithIncludeNotForSale(true).
+			WithLimit(60).
+			WithCursor("nextPageCursor")
+
+		params := builder.Build()
+		assert.Equal(t, types.CatalogCategoryAccessories, params.Category)
+		assert.Equal(t, types.CatalogSubcategoryHats, params.Subcategory)
+		assert.Equal(t, "crown", params.Keyword)
+		assert.Equal(t, "Roblox", params.CreatorName)
+		require.NotNil(t, params.MinPrice)
+		require.NotNil(t, params.MaxPrice)
+		assert.Equal(t, int64(10), *params.MinPrice)
+		assert.Equal(t, int64(500), *params.MaxPrice)
+		assert.Equal(t, types.CatalogSalesTypeCollectibles, params.SalesType)
+		require.NotNil(t, params.SortType)
+		assert.Equal(t, types.CatalogSortTypePriceLowToHigh, *params.SortType)
+		assert.True(t, params.IncludeNotForSale)
+		assert.Equal(t, int64(60), params.Limit)
+		assert.Equal(t, "nextPageCursor", params.Cursor)
+	})
+}
@@ -0,0 +1,88 @@
+package economy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetCollectibleItemInstances fetches the instances of a collectible item owned by a user.
+// The collectible item ID can be found in types.CatalogItem.CollectibleItemID.
+// GET https://apis.roblox.com/marketplace-sales/v1/item/{collectibleItemId}/resellable-instances
+func (r *Resource) GetCollectibleItemInstances(ctx context.Context, p CollectibleItemInstancesParams) (*types.CollectibleItemInstancesResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+
+	var instances types.CollectibleItemInstancesResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/marketplace-sales/v1/item/%s/resellable-instances", types.ApisEndpoint, p.CollectibleItemID)).
+		Query("ownerType", "User").
+		Query("ownerId", strconv.FormatInt(p.OwnerID, 10)).
+		Query("limit", strconv.FormatInt(p.Limit, 10)).
+		Query("cursor", p.Cursor).
+		Result(&instances).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&instances); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &instances, nil
+}
+
+// CollectibleItemInstancesParams holds the parameters for getting collectible item instances.
+type CollectibleItemInstancesParams struct {
+	CollectibleItemID string `json:"collectibleItemId" validate:"required,uuid"`
+	OwnerID           int64  `json:"ownerId"           validate:"required,gt=0"`
+	Limit             int64  `json:"limit"             validate:"min=1,max=500"`
+	Cursor            string `json:"cursor"            validate:"omitempty"`
+}
+
+// CollectibleItemInstancesBuilder is a builder for CollectibleItemInstancesParams.
+type CollectibleItemInstancesBuilder struct {
+	params CollectibleItemInstancesParams
+}
+
+// NewCollectibleItemInstancesBuilder creates a new CollectibleItemInstancesBuilder with default values.
+func NewCollectibleItemInstancesBuilder(collectibleItemID string, ownerID int64) *CollectibleItemInstancesBuilder {
+	return &CollectibleItemInstancesBuilder{
+		params: CollectibleItemInstancesParams{
+			CollectibleItemID: collectibleItemID,
+			OwnerID:           ownerID,
+			Limit:             100,
+			Cursor:            "",
+		},
+	}
+}
+
+// WithLimit sets the limit.
+func (b *CollectibleItemInstancesBuilder) WithLimit(limit int64) *CollectibleItemInstancesBuilder {
+	b.params.Limit = limit
+	return b
+}
+
+// WithCursor sets the cursor.
+func (b *CollectibleItemInstancesBuilder) WithCursor(cursor string) *CollectibleItemInstancesBuilder {
+	b.params.Cursor = cursor
+	return b
+}
+
+// Build returns the CollectibleItemInstancesParams.
+func (b *CollectibleItemInstancesBuilder) Build() CollectibleItemInstancesParams {
+	return b.params
+}
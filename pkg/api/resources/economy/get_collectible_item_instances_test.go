@@ -0,0 +1,42 @@
+package economy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/economy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCollectibleItemInstances(t *testing.T) {
+	// Create a new test resource
+	api := economy.New(utils.NewTestEnv())
+
+	t.Run("Invalid Collectible Item ID", func(t *testing.T) {
+		builder := economy.NewCollectibleItemInstancesBuilder("not-a-uuid", utils.SampleUserID1)
+		_, err := api.GetCollectibleItemInstances(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "CollectibleItemID")
+	})
+
+	t.Run("Invalid Owner ID", func(t *testing.T) {
+		builder := economy.NewCollectibleItemInstancesBuilder("6c9d8f5a-7b3e-4f2a-9c1d-0e8b7a6f5d4c", utils.InvalidUserID)
+		_, err := api.GetCollectibleItemInstances(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "OwnerID")
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := economy.NewCollectibleItemInstancesBuilder("6c9d8f5a-7b3e-4f2a-9c1d-0e8b7a6f5d4c", utils.SampleUserID1).
+			WithLimit(500).
+			WithCursor("nextPageCursor")
+
+		params := builder.Build()
+		assert.Equal(t, "6c9d8f5a-7b3e-4f2a-9c1d-0e8b7a6f5d4c", params.CollectibleItemID)
+		assert.Equal(t, utils.SampleUserID1, params.OwnerID)
+		assert.Equal(t, int64(500), params.Limit)
+		assert.Equal(t, "nextPageCursor", params.Cursor)
+	})
+}
@@ -0,0 +1,51 @@
+package economy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetUserCurrency fetches the Robux balance of the authenticated user.
+// GET https://economy.roblox.com/v1/users/{userId}/currency
+func (r *Resource) GetUserCurrency(ctx context.Context, userID int64) (*types.CurrencyResponse, error) {
+	return r.getCurrency(ctx, "%s/v1/users/%d/currency", userID)
+}
+
+// GetGroupCurrency fetches the Robux balance of a group the authenticated user can view the funds of.
+// GET https://economy.roblox.com/v1/groups/{groupId}/currency
+func (r *Resource) GetGroupCurrency(ctx context.Context, groupID int64) (*types.CurrencyResponse, error) {
+	return r.getCurrency(ctx, "%s/v1/groups/%d/currency", groupID)
+}
+
+// getCurrency fetches the Robux balance for the given target.
+func (r *Resource) getCurrency(ctx context.Context, urlFormat string, targetID int64) (*types.CurrencyResponse, error) {
+	if err := r.validate.Var(targetID, "required,gt=0"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+
+	var currency types.CurrencyResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf(urlFormat, types.EconomyEndpoint, targetID)).
+		Result(&currency).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&currency); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &currency, nil
+}
@@ -0,0 +1,40 @@
+package economy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/economy"
+	"github.com/jaxron/roapi.go/pkg/api/resources/users"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCurrency(t *testing.T) {
+	// Create a new test resource
+	c, v := utils.NewTestEnv()
+	api := economy.New(c, v)
+
+	t.Run("Fetch Authenticated User Currency", func(t *testing.T) {
+		user, err := users.New(c, v).GetAuthUserInfo(context.Background())
+		require.NoError(t, err)
+
+		result, err := api.GetUserCurrency(context.Background(), user.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.GreaterOrEqual(t, result.Robux, int64(0))
+	})
+
+	t.Run("Fetch Other User Currency", func(t *testing.T) {
+		result, err := api.GetUserCurrency(context.Background(), utils.SampleUserID5)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid Group ID", func(t *testing.T) {
+		result, err := api.GetGroupCurrency(context.Background(), 0)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
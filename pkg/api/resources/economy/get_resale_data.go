@@ -0,0 +1,40 @@
+package economy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetResaleData fetches the resale history of a limited asset.
+// GET https://economy.roblox.com/v1/assets/{assetId}/resale-data
+func (r *Resource) GetResaleData(ctx context.Context, assetID int64) (*types.ResaleDataResponse, error) {
+	if err := r.validate.Var(assetID, "required,gt=0"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+
+	var resaleData types.ResaleDataResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/assets/%d/resale-data", types.EconomyEndpoint, assetID)).
+		Result(&resaleData).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&resaleData); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &resaleData, nil
+}
@@ -0,0 +1,40 @@
+package economy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/economy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetResaleData(t *testing.T) {
+	// Create a new test resource
+	api := economy.New(utils.NewTestEnv())
+
+	t.Run("Fetch Known Limited Resale Data", func(t *testing.T) {
+		result, err := api.GetResaleData(context.Background(), utils.SampleLimitedAssetID)
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Positive(t, result.Sales)
+		assert.Positive(t, result.RecentAveragePrice)
+		assert.NotEmpty(t, result.PriceDataPoints)
+
+		for _, point := range result.PriceDataPoints {
+			assert.False(t, point.Date.IsZero())
+		}
+	})
+
+	t.Run("Fetch Non-limited Asset Resale Data", func(t *testing.T) {
+		result, err := api.GetResaleData(context.Background(), utils.SampleAssetID)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid Asset ID", func(t *testing.T) {
+		_, err := api.GetResaleData(context.Background(), utils.InvalidAssetID)
+		require.Error(t, err)
+	})
+}
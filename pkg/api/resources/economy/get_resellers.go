@@ -0,0 +1,83 @@
+package economy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetResellers fetches the paginated list of resale listings for a limited asset.
+// GET https://economy.roblox.com/v1/assets/{assetId}/resellers
+func (r *Resource) GetResellers(ctx context.Context, p ResellersParams) (*types.ResellersResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+
+	var resellers types.ResellersResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/assets/%d/resellers", types.EconomyEndpoint, p.AssetID)).
+		Query("limit", strconv.FormatInt(p.Limit, 10)).
+		Query("cursor", p.Cursor).
+		Result(&resellers).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&resellers); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &resellers, nil
+}
+
+// ResellersParams holds the parameters for getting asset resellers.
+type ResellersParams struct {
+	AssetID int64  `json:"assetId" validate:"required,gt=0"`
+	Limit   int64  `json:"limit"   validate:"oneof=10 25 50 100"`
+	Cursor  string `json:"cursor"  validate:"omitempty"`
+}
+
+// ResellersBuilder is a builder for ResellersParams.
+type ResellersBuilder struct {
+	params ResellersParams
+}
+
+// NewResellersBuilder creates a new ResellersBuilder with default values.
+func NewResellersBuilder(assetID int64) *ResellersBuilder {
+	return &ResellersBuilder{
+		params: ResellersParams{
+			AssetID: assetID,
+			Limit:   10,
+			Cursor:  "",
+		},
+	}
+}
+
+// WithLimit sets the limit.
+func (b *ResellersBuilder) WithLimit(limit int64) *ResellersBuilder {
+	b.params.Limit = limit
+	return b
+}
+
+// WithCursor sets the cursor.
+func (b *ResellersBuilder) WithCursor(cursor string) *ResellersBuilder {
+	b.params.Cursor = cursor
+	return b
+}
+
+// Build returns the ResellersParams.
+func (b *ResellersBuilder) Build() ResellersParams {
+	return b.params
+}
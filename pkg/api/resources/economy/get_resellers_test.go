@@ -0,0 +1,47 @@
+package economy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/economy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetResellers(t *testing.T) {
+	// Create a new test resource
+	api := economy.New(utils.NewTestEnv())
+
+	t.Run("Fetch Known Limited Resellers", func(t *testing.T) {
+		builder := economy.NewResellersBuilder(utils.SampleLimitedAssetID)
+		result, err := api.GetResellers(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+
+		for _, reseller := range result.Data {
+			assert.NotZero(t, reseller.UserAssetID)
+			assert.NotZero(t, reseller.Seller.ID)
+			assert.Positive(t, reseller.Price)
+		}
+	})
+
+	t.Run("Invalid Limit", func(t *testing.T) {
+		builder := economy.NewResellersBuilder(utils.SampleLimitedAssetID).WithLimit(30)
+		_, err := api.GetResellers(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Limit")
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := economy.NewResellersBuilder(utils.SampleLimitedAssetID).
+			WithLimit(100).
+			WithCursor("nextPageCursor")
+
+		params := builder.Build()
+		assert.Equal(t, utils.SampleLimitedAssetID, params.AssetID)
+		assert.Equal(t, int64(100), params.Limit)
+		assert.Equal(t, "nextPageCursor", params.Cursor)
+	})
+}
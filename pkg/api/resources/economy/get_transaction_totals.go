@@ -0,0 +1,78 @@
+package economy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetUserTransactionTotals fetches the transaction totals of the authenticated user over a time frame.
+// GET https://economy.roblox.com/v2/users/{userId}/transaction-totals
+func (r *Resource) GetUserTransactionTotals(ctx context.Context, p TransactionTotalsParams) (*types.TransactionTotalsResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+
+	var totals types.TransactionTotalsResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v2/users/%d/transaction-totals", types.EconomyEndpoint, p.TargetID)).
+		Query("timeFrame", string(p.TimeFrame)).
+		Query("transactionType", "summary").
+		Result(&totals).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&totals); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &totals, nil
+}
+
+// GetGroupRevenueSummary fetches the revenue totals of a group over a time frame.
+// GET https://economy.roblox.com/v1/groups/{groupId}/revenue/summary/{timeFrame}
+func (r *Resource) GetGroupRevenueSummary(ctx context.Context, p TransactionTotalsParams) (*types.GroupRevenueSummaryResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+
+	var summary types.GroupRevenueSummaryResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/groups/%d/revenue/summary/%s", types.EconomyEndpoint, p.TargetID, p.TimeFrame)).
+		Result(&summary).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&summary); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &summary, nil
+}
+
+// TransactionTotalsParams holds the parameters for getting transaction totals.
+// TargetID is the user ID or group ID depending on the method called.
+type TransactionTotalsParams struct {
+	TargetID  int64                      `json:"targetId"  validate:"required,gt=0"`
+	TimeFrame types.TransactionTimeFrame `json:"timeFrame" validate:"required,oneof=Day Week Month Year"`
+}
@@ -0,0 +1,40 @@
+package economy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/economy"
+	"github.com/jaxron/roapi.go/pkg/api/resources/users"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTransactionTotals(t *testing.T) {
+	// Create a new test resource
+	c, v := utils.NewTestEnv()
+	api := economy.New(c, v)
+
+	t.Run("Fetch Authenticated User Totals", func(t *testing.T) {
+		user, err := users.New(c, v).GetAuthUserInfo(context.Background())
+		require.NoError(t, err)
+
+		result, err := api.GetUserTransactionTotals(context.Background(), economy.TransactionTotalsParams{
+			TargetID:  user.ID,
+			TimeFrame: types.TransactionTimeFrameYear,
+		})
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("Invalid Time Frame", func(t *testing.T) {
+		_, err := api.GetGroupRevenueSummary(context.Background(), economy.TransactionTotalsParams{
+			TargetID:  utils.SampleGroupID,
+			TimeFrame: "Decade",
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "TimeFrame")
+	})
+}
@@ -0,0 +1,106 @@
+package economy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetUserTransactions fetches the paginated transaction history of the authenticated user.
+// Each transaction's details payload is decoded into the struct matching its type.
+// GET https://economy.roblox.com/v2/users/{userId}/transactions
+func (r *Resource) GetUserTransactions(ctx context.Context, p TransactionsParams) (*types.TransactionsResponse, error) {
+	return r.getTransactions(ctx, "%s/v2/users/%d/transactions", p)
+}
+
+// GetGroupTransactions fetches the paginated transaction history of a group.
+// Each transaction's details payload is decoded into the struct matching its type.
+// GET https://economy.roblox.com/v2/groups/{groupId}/transactions
+func (r *Resource) GetGroupTransactions(ctx context.Context, p TransactionsParams) (*types.TransactionsResponse, error) {
+	return r.getTransactions(ctx, "%s/v2/groups/%d/transactions", p)
+}
+
+// getTransactions fetches a transaction page for the given target and decodes the details.
+func (r *Resource) getTransactions(ctx context.Context, urlFormat string, p TransactionsParams) (*types.TransactionsResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+
+	var transactions types.TransactionsResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf(urlFormat, types.EconomyEndpoint, p.TargetID)).
+		Query("transactionType", string(p.TransactionType)).
+		Query("limit", strconv.FormatInt(p.Limit, 10)).
+		Query("cursor", p.Cursor).
+		Result(&transactions).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&transactions); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	for i := range transactions.Data {
+		if err := transactions.Data[i].DecodeDetails(p.TransactionType); err != nil {
+			return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+		}
+	}
+
+	return &transactions, nil
+}
+
+// TransactionsParams holds the parameters for getting transaction history.
+// TargetID is the user ID or group ID depending on the method called.
+type TransactionsParams struct {
+	TargetID        int64                 `json:"targetId"        validate:"required,gt=0"`
+	TransactionType types.TransactionType `json:"transactionType" validate:"required,oneof=Purchase Sale AffiliateSale DevEx GroupPayout AdImpressionPayout CurrencyPurchase TradeRobux PremiumStipend EngagementPayout GroupEngagementPayout AdSpend PublishingAdvanceRebates AffiliatePayout"`
+	Limit           int64                 `json:"limit"           validate:"oneof=10 25 50 100"`
+	Cursor          string                `json:"cursor"          validate:"omitempty"`
+}
+
+// TransactionsBuilder is a builder for TransactionsParams.
+type TransactionsBuilder struct {
+	params TransactionsParams
+}
+
+// NewTransactionsBuilder creates a new TransactionsBuilder with default values.
+func NewTransactionsBuilder(targetID int64, transactionType types.TransactionType) *TransactionsBuilder {
+	return &TransactionsBuilder{
+		params: TransactionsParams{
+			TargetID:        targetID,
+			TransactionType: transactionType,
+			Limit:           10,
+			Cursor:          "",
+		},
+	}
+}
+
+// WithLimit sets the limit.
+func (b *TransactionsBuilder) WithLimit(limit int64) *TransactionsBuilder {
+	b.params.Limit = limit
+	return b
+}
+
+// WithCursor sets the cursor.
+func (b *TransactionsBuilder) WithCursor(cursor string) *TransactionsBuilder {
+	b.params.Cursor = cursor
+	return b
+}
+
+// Build returns the TransactionsParams.
+func (b *TransactionsBuilder) Build() TransactionsParams {
+	return b.params
+}
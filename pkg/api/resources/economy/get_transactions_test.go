@@ -0,0 +1,67 @@
+package economy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/economy"
+	"github.com/jaxron/roapi.go/pkg/api/resources/users"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTransactions(t *testing.T) {
+	// Create a new test resource
+	c, v := utils.NewTestEnv()
+	api := economy.New(c, v)
+
+	t.Run("Fetch Authenticated User Purchases", func(t *testing.T) {
+		user, err := users.New(c, v).GetAuthUserInfo(context.Background())
+		require.NoError(t, err)
+
+		builder := economy.NewTransactionsBuilder(user.ID, types.TransactionTypePurchase).WithLimit(25)
+		result, err := api.GetUserTransactions(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+
+		for _, transaction := range result.Data {
+			assert.NotZero(t, transaction.ID)
+			assert.IsType(t, &types.PurchaseDetails{}, transaction.Details)
+		}
+	})
+
+	t.Run("Invalid Limit", func(t *testing.T) {
+		builder := economy.NewTransactionsBuilder(utils.SampleUserID1, types.TransactionTypeSale).WithLimit(30)
+		_, err := api.GetUserTransactions(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Limit")
+	})
+
+	t.Run("Invalid Transaction Type", func(t *testing.T) {
+		builder := economy.NewTransactionsBuilder(utils.SampleUserID1, types.TransactionType("Unknown"))
+		_, err := api.GetUserTransactions(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "TransactionType")
+	})
+
+	t.Run("Invalid Group ID", func(t *testing.T) {
+		builder := economy.NewTransactionsBuilder(0, types.TransactionTypeSale)
+		_, err := api.GetGroupTransactions(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "TargetID")
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := economy.NewTransactionsBuilder(utils.SampleGroupID, types.TransactionTypeGroupPayout).
+			WithLimit(100).
+			WithCursor("nextPageCursor")
+
+		params := builder.Build()
+		assert.Equal(t, utils.SampleGroupID, params.TargetID)
+		assert.Equal(t, types.TransactionTypeGroupPayout, params.TransactionType)
+		assert.Equal(t, int64(100), params.Limit)
+		assert.Equal(t, "nextPageCursor", params.Cursor)
+	})
+}
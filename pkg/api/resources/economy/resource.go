@@ -0,0 +1,39 @@
+package economy
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ResourceInterface defines the interface for economy-related operations.
+type ResourceInterface interface {
+	GetResaleData(ctx context.Context, assetID int64) (*types.ResaleDataResponse, error)
+	GetResellers(ctx context.Context, p ResellersParams) (*types.ResellersResponse, error)
+	GetCollectibleItemInstances(ctx context.Context, p CollectibleItemInstancesParams) (*types.CollectibleItemInstancesResponse, error)
+	GetUserCurrency(ctx context.Context, userID int64) (*types.CurrencyResponse, error)
+	GetGroupCurrency(ctx context.Context, groupID int64) (*types.CurrencyResponse, error)
+	GetUserTransactions(ctx context.Context, p TransactionsParams) (*types.TransactionsResponse, error)
+	GetGroupTransactions(ctx context.Context, p TransactionsParams) (*types.TransactionsResponse, error)
+	GetUserTransactionTotals(ctx context.Context, p TransactionTotalsParams) (*types.TransactionTotalsResponse, error)
+	GetGroupRevenueSummary(ctx context.Context, p TransactionTotalsParams) (*types.GroupRevenueSummaryResponse, error)
+}
+
+// Ensure Resource implements the ResourceInterface.
+var _ ResourceInterface = (*Resource)(nil)
+
+// Resource provides methods for interacting with economy-related endpoints.
+type Resource struct {
+	client   *client.Client
+	validate *validator.Validate
+}
+
+// New creates a new Resource with the specified client and validator.
+func New(client *client.Client, validate *validator.Validate) *Resource {
+	return &Resource{
+		client:   client,
+		validate: validate,
+	}
+}
@@ -0,0 +1,59 @@
+package games_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/games"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGroupGames(t *testing.T) {
+	// Create a new test resource
+	api := games.New(utils.NewTestEnv())
+
+	t.Run("Fetch Group Games Successfully", func(t *testing.T) {
+		builder := games.NewGroupGamesBuilder(utils.SampleGroupID2)
+		result, err := api.GetGroupGames(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.Data)
+
+		for _, game := range result.Data {
+			assert.Equal(t, utils.SampleGroupID2, game.Creator.ID)
+			assert.Equal(t, "Group", game.Creator.Type)
+		}
+	})
+
+	t.Run("Fetch With Invalid Group ID", func(t *testing.T) {
+		builder := games.NewGroupGamesBuilder(0)
+		_, err := api.GetGroupGames(context.Background(), builder.Build())
+		require.Error(t, err)
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := games.NewGroupGamesBuilder(utils.SampleGroupID2).
+			WithAccessFilter(games.AccessFilterPublic).
+			WithLimit(100).
+			WithCursor("nextPageCursor").
+			WithSortOrder(types.SortOrderDesc)
+
+		params := builder.Build()
+		assert.Equal(t, utils.SampleGroupID2, params.GroupID)
+		assert.Equal(t, games.AccessFilterPublic, params.AccessFilter)
+		assert.Equal(t, int64(100), params.Limit)
+		assert.Equal(t, "nextPageCursor", params.Cursor)
+		assert.Equal(t, types.SortOrderDesc, params.SortOrder)
+	})
+
+	t.Run("Invalid Limit", func(t *testing.T) {
+		builder := games.NewGroupGamesBuilder(utils.SampleGroupID2).
+			WithLimit(30) // Invalid limit (not 10, 25, 50 or 100)
+		_, err := api.GetGroupGames(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Limit")
+	})
+}
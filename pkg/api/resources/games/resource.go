@@ -17,6 +17,7 @@ type ResourceInterface interface {
 	GetGameServers(ctx context.Context, p GameServersParams) (*types.ServerResponse, error)
 	GetMultiplePlaceDetails(ctx context.Context, placeIDs []int64) ([]*types.PlaceDetailResponse, error)
 	GetUserFavoriteGames(ctx context.Context, p UserFavoriteGamesParams) (*types.GameResponse, error)
+	GetGroupGames(ctx context.Context, p GroupGamesParams) (*types.GameResponse, error)
 }
 
 // Ensure Resource implements ResourceInterface.
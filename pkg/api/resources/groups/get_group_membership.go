@@ -0,0 +1,40 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetGroupMembership fetches the authenticated user's membership metadata for a specific group.
+// GET https://groups.roblox.com/v1/groups/{groupId}/membership
+func (r *Resource) GetGroupMembership(ctx context.Context, groupID int64) (*types.GroupMembershipResponse, error) {
+	if err := r.validate.Var(groupID, "required,gt=0"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+
+	var membership types.GroupMembershipResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/groups/%d/membership", types.GroupsEndpoint, groupID)).
+		Result(&membership).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&membership); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &membership, nil
+}
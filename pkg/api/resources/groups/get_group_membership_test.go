@@ -0,0 +1,32 @@
+package groups_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/groups"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGroupMembership(t *testing.T) {
+	// Create a new test resource
+	api := groups.New(utils.NewTestEnv())
+
+	// Test case: Fetch membership metadata for a known group
+	t.Run("Fetch Known Group Membership", func(t *testing.T) {
+		membership, err := api.GetGroupMembership(context.Background(), utils.SampleGroupID)
+		require.NoError(t, err)
+		assert.NotNil(t, membership)
+		assert.Equal(t, utils.SampleGroupID, membership.GroupID)
+		assert.NotEmpty(t, membership.UserRole.Role.Name)
+	})
+
+	// Test case: Attempt to fetch membership for a non-existent group
+	t.Run("Fetch Non-existent Group Membership", func(t *testing.T) {
+		membership, err := api.GetGroupMembership(context.Background(), utils.InvalidGroupID)
+		require.Error(t, err)
+		assert.Nil(t, membership)
+	})
+}
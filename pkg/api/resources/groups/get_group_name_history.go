@@ -0,0 +1,95 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetGroupNameHistory fetches the name history for a specific group.
+// GET https://groups.roblox.com/v1/groups/{groupId}/name-history
+func (r *Resource) GetGroupNameHistory(ctx context.Context, p GroupNameHistoryParams) (*types.GroupNameHistoryResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var history types.GroupNameHistoryResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/groups/%d/name-history", types.GroupsEndpoint, p.GroupID)).
+		Query("limit", strconv.FormatInt(p.Limit, 10)).
+		Query("cursor", p.Cursor).
+		Query("sortOrder", string(p.SortOrder)).
+		Result(&history).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&history); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &history, nil
+}
+
+// GroupNameHistoryParams holds the parameters for getting group name history.
+type GroupNameHistoryParams struct {
+	GroupID   int64           `json:"groupId"   validate:"required,gt=0"`
+	Limit     int64           `json:"limit"     validate:"omitempty,oneof=10 25 50 100"`
+	Cursor    string          `json:"cursor"    validate:"omitempty"`
+	SortOrder types.SortOrder `json:"sortOrder" validate:"omitempty,oneof=Asc Desc"`
+}
+
+// GroupNameHistoryBuilder is a builder for GroupNameHistoryParams.
+type GroupNameHistoryBuilder struct {
+	params GroupNameHistoryParams
+}
+
+// NewGroupNameHistoryBuilder creates a new GroupNameHistoryBuilder with default values.
+func NewGroupNameHistoryBuilder(groupID int64) *GroupNameHistoryBuilder {
+	return &GroupNameHistoryBuilder{
+		params: GroupNameHistoryParams{
+			GroupID:   groupID,
+			Limit:     10,
+			Cursor:    "",
+			SortOrder: "",
+		},
+	}
+}
+
+// WithLimit sets the limit.
+func (b *GroupNameHistoryBuilder) WithLimit(limit int64) *GroupNameHistoryBuilder {
+	b.params.Limit = limit
+	return b
+}
+
+// WithCursor sets the cursor.
+func (b *GroupNameHistoryBuilder) WithCursor(cursor string) *GroupNameHistoryBuilder {
+	b.params.Cursor = cursor
+	return b
+}
+
+// WithSortOrderAsc sets the sort order to ascending.
+func (b *GroupNameHistoryBuilder) WithSortOrderAsc() *GroupNameHistoryBuilder {
+	b.params.SortOrder = types.SortOrderAsc
+	return b
+}
+
+// WithSortOrderDesc sets the sort order to descending.
+func (b *GroupNameHistoryBuilder) WithSortOrderDesc() *GroupNameHistoryBuilder {
+	b.params.SortOrder = types.SortOrderDesc
+	return b
+}
+
+// Build returns the GroupNameHistoryParams.
+func (b *GroupNameHistoryBuilder) Build() GroupNameHistoryParams {
+	return b.params
+}
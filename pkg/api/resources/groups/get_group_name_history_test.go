@@ -0,0 +1,61 @@
+package groups_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/groups"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGroupNameHistory(t *testing.T) {
+	// Create a new test resource
+	api := groups.New(utils.NewTestEnv())
+
+	// Test case: Fetch name history for a known group
+	t.Run("Fetch Known Group Name History", func(t *testing.T) {
+		builder := groups.NewGroupNameHistoryBuilder(utils.SampleGroupID).WithLimit(100)
+		history, err := api.GetGroupNameHistory(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, history)
+
+		// Check if previous names are properly populated
+		for _, name := range history.Data {
+			assert.NotEmpty(t, name.Name)
+			assert.NotZero(t, name.Created)
+		}
+	})
+
+	// Test case: Attempt to fetch name history for a non-existent group
+	t.Run("Fetch Non-existent Group Name History", func(t *testing.T) {
+		builder := groups.NewGroupNameHistoryBuilder(utils.InvalidGroupID)
+		history, err := api.GetGroupNameHistory(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Nil(t, history)
+	})
+
+	// Test case: Validate with invalid Limit
+	t.Run("Invalid Limit", func(t *testing.T) {
+		builder := groups.NewGroupNameHistoryBuilder(utils.SampleGroupID).WithLimit(101)
+		_, err := api.GetGroupNameHistory(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Limit")
+	})
+
+	// Test case: Valid parameters with all fields set
+	t.Run("Valid Parameters", func(t *testing.T) {
+		builder := groups.NewGroupNameHistoryBuilder(utils.SampleGroupID).
+			WithLimit(50).
+			WithCursor("someCursor").
+			WithSortOrderAsc()
+
+		params := builder.Build()
+		assert.Equal(t, utils.SampleGroupID, params.GroupID)
+		assert.Equal(t, int64(50), params.Limit)
+		assert.Equal(t, "someCursor", params.Cursor)
+		assert.Equal(t, types.SortOrderAsc, params.SortOrder)
+	})
+}
@@ -0,0 +1,82 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetGroupPolicies fetches the policy information for multiple groups.
+// POST https://groups.roblox.com/v1/groups/policies
+func (r *Resource) GetGroupPolicies(ctx context.Context, p GroupPoliciesParams) (*types.GroupPoliciesResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+	ctx = context.WithValue(ctx, auth.KeyAddToken, true)
+
+	var policies types.GroupPoliciesResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(types.GroupsEndpoint + "/v1/groups/policies").
+		MarshalBody(p).
+		Result(&policies).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&policies); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &policies, nil
+}
+
+// GroupPoliciesParams holds the parameters for getting group policies.
+type GroupPoliciesParams struct {
+	GroupIDs []int64 `json:"groupIds" validate:"required,min=1,max=100,dive,gt=0"`
+}
+
+// GroupPoliciesBuilder is a builder for GroupPoliciesParams.
+type GroupPoliciesBuilder struct {
+	params GroupPoliciesParams
+}
+
+// NewGroupPoliciesBuilder creates a new GroupPoliciesBuilder.
+func NewGroupPoliciesBuilder(groupIDs ...int64) *GroupPoliciesBuilder {
+	return &GroupPoliciesBuilder{
+		params: GroupPoliciesParams{
+			GroupIDs: groupIDs,
+		},
+	}
+}
+
+// WithGroupIDs adds multiple group IDs to the list.
+func (b *GroupPoliciesBuilder) WithGroupIDs(groupIDs ...int64) *GroupPoliciesBuilder {
+	b.params.GroupIDs = append(b.params.GroupIDs, groupIDs...)
+	return b
+}
+
+// RemoveGroupIDs removes multiple group IDs from the list.
+func (b *GroupPoliciesBuilder) RemoveGroupIDs(groupIDs ...int64) *GroupPoliciesBuilder {
+	b.params.GroupIDs = slices.DeleteFunc(b.params.GroupIDs, func(id int64) bool {
+		return slices.Contains(groupIDs, id)
+	})
+
+	return b
+}
+
+// Build returns the GroupPoliciesParams.
+func (b *GroupPoliciesBuilder) Build() GroupPoliciesParams {
+	return b.params
+}
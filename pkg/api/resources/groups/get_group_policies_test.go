@@ -0,0 +1,47 @@
+package groups_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/groups"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGroupPolicies(t *testing.T) {
+	// Create a new test resource
+	api := groups.New(utils.NewTestEnv())
+
+	// Test case: Fetch policies for known groups
+	t.Run("Fetch Known Group Policies", func(t *testing.T) {
+		builder := groups.NewGroupPoliciesBuilder(utils.SampleGroupID, utils.SampleGroupID2)
+		policies, err := api.GetGroupPolicies(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, policies)
+		assert.Len(t, policies.Groups, 2)
+
+		for _, policy := range policies.Groups {
+			assert.Contains(t, []int64{utils.SampleGroupID, utils.SampleGroupID2}, policy.GroupID)
+		}
+	})
+
+	// Test case: Validate with empty group IDs
+	t.Run("Empty Group IDs", func(t *testing.T) {
+		builder := groups.NewGroupPoliciesBuilder()
+		_, err := api.GetGroupPolicies(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "GroupIDs")
+	})
+
+	// Test case: Test builder methods
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := groups.NewGroupPoliciesBuilder().
+			WithGroupIDs(1, 2, 3, 4).
+			RemoveGroupIDs(2, 3)
+
+		params := builder.Build()
+		assert.Equal(t, []int64{1, 4}, params.GroupIDs)
+	})
+}
@@ -0,0 +1,44 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetGroupRelationshipRequests fetches the paginated list of incoming relationship requests for a group.
+// This requires the authenticated user to have permission to manage the group's relationships.
+// GET https://groups.roblox.com/v1/groups/{groupId}/relationships/{groupRelationshipType}/requests
+func (r *Resource) GetGroupRelationshipRequests(ctx context.Context, p GroupRelationshipsParams) (*types.GroupRelationshipsResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+
+	var requests types.GroupRelationshipsResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/groups/%d/relationships/%s/requests", types.GroupsEndpoint, p.GroupID, p.RelationshipType)).
+		Query("StartRowIndex", strconv.FormatInt(p.StartRowIndex, 10)).
+		Query("MaxRows", strconv.FormatInt(p.MaxRows, 10)).
+		Result(&requests).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&requests); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &requests, nil
+}
@@ -0,0 +1,33 @@
+package groups_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/groups"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGroupRelationshipRequests(t *testing.T) {
+	// Create a new test resource
+	api := groups.New(utils.NewTestEnv())
+
+	// Test case: Attempt to fetch requests for a group the test account cannot manage
+	t.Run("Fetch Requests Without Permission", func(t *testing.T) {
+		builder := groups.NewGroupRelationshipsBuilder(utils.SampleGroupID3, types.GroupRelationshipAllies)
+		requests, err := api.GetGroupRelationshipRequests(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Nil(t, requests)
+	})
+
+	// Test case: Validate with invalid MaxRows
+	t.Run("Invalid MaxRows", func(t *testing.T) {
+		builder := groups.NewGroupRelationshipsBuilder(utils.SampleGroupID3, types.GroupRelationshipAllies).WithMaxRows(0)
+		_, err := api.GetGroupRelationshipRequests(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "MaxRows")
+	})
+}
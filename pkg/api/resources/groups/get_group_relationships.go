@@ -0,0 +1,82 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetGroupRelationships fetches the paginated list of allies or enemies of a group.
+// GET https://groups.roblox.com/v1/groups/{groupId}/relationships/{groupRelationshipType}
+func (r *Resource) GetGroupRelationships(ctx context.Context, p GroupRelationshipsParams) (*types.GroupRelationshipsResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var relationships types.GroupRelationshipsResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/groups/%d/relationships/%s", types.GroupsEndpoint, p.GroupID, p.RelationshipType)).
+		Query("StartRowIndex", strconv.FormatInt(p.StartRowIndex, 10)).
+		Query("MaxRows", strconv.FormatInt(p.MaxRows, 10)).
+		Result(&relationships).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&relationships); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &relationships, nil
+}
+
+// GroupRelationshipsParams holds the parameters for getting group relationships.
+type GroupRelationshipsParams struct {
+	GroupID          int64                       `json:"groupId"          validate:"required,gt=0"`
+	RelationshipType types.GroupRelationshipType `json:"relationshipType" validate:"required,oneof=Allies Enemies"`
+	StartRowIndex    int64                       `json:"startRowIndex"    validate:"min=0"`
+	MaxRows          int64                       `json:"maxRows"          validate:"min=1,max=100"`
+}
+
+// GroupRelationshipsBuilder is a builder for GroupRelationshipsParams.
+type GroupRelationshipsBuilder struct {
+	params GroupRelationshipsParams
+}
+
+// NewGroupRelationshipsBuilder creates a new GroupRelationshipsBuilder with default values.
+func NewGroupRelationshipsBuilder(groupID int64, relationshipType types.GroupRelationshipType) *GroupRelationshipsBuilder {
+	return &GroupRelationshipsBuilder{
+		params: GroupRelationshipsParams{
+			GroupID:          groupID,
+			RelationshipType: relationshipType,
+			StartRowIndex:    0,
+			MaxRows:          50,
+		},
+	}
+}
+
+// WithStartRowIndex sets the row index to start from.
+func (b *GroupRelationshipsBuilder) WithStartRowIndex(startRowIndex int64) *GroupRelationshipsBuilder {
+	b.params.StartRowIndex = startRowIndex
+	return b
+}
+
+// WithMaxRows sets the maximum number of rows to return.
+func (b *GroupRelationshipsBuilder) WithMaxRows(maxRows int64) *GroupRelationshipsBuilder {
+	b.params.MaxRows = maxRows
+	return b
+}
+
+// Build returns the GroupRelationshipsParams.
+func (b *GroupRelationshipsBuilder) Build() GroupRelationshipsParams {
+	return b.params
+}
@@ -0,0 +1,88 @@
+package groups_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/groups"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGroupRelationships(t *testing.T) {
+	// Create a new test resource
+	api := groups.New(utils.NewTestEnv())
+
+	// Test case: Fetch allies for a known group
+	t.Run("Fetch Known Group Allies", func(t *testing.T) {
+		builder := groups.NewGroupRelationshipsBuilder(utils.SampleGroupID3, types.GroupRelationshipAllies)
+		relationships, err := api.GetGroupRelationships(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, relationships)
+		assert.Equal(t, utils.SampleGroupID3, relationships.GroupID)
+		assert.Equal(t, types.GroupRelationshipAllies, relationships.RelationshipType)
+
+		// Check if related groups are properly populated
+		for _, group := range relationships.RelatedGroups {
+			assert.NotZero(t, group.ID)
+			assert.NotEmpty(t, group.Name)
+		}
+	})
+
+	// Test case: Fetch enemies for a known group
+	t.Run("Fetch Known Group Enemies", func(t *testing.T) {
+		builder := groups.NewGroupRelationshipsBuilder(utils.SampleGroupID3, types.GroupRelationshipEnemies)
+		relationships, err := api.GetGroupRelationships(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, relationships)
+		assert.Equal(t, types.GroupRelationshipEnemies, relationships.RelationshipType)
+	})
+
+	// Test case: Attempt to fetch relationships for a non-existent group
+	t.Run("Fetch Non-existent Group Relationships", func(t *testing.T) {
+		builder := groups.NewGroupRelationshipsBuilder(utils.InvalidGroupID, types.GroupRelationshipAllies)
+		relationships, err := api.GetGroupRelationships(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Nil(t, relationships)
+	})
+
+	// Test case: Validate with invalid relationship type
+	t.Run("Invalid Relationship Type", func(t *testing.T) {
+		builder := groups.NewGroupRelationshipsBuilder(utils.SampleGroupID3, "Friends")
+		_, err := api.GetGroupRelationships(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "RelationshipType")
+	})
+
+	// Test case: Valid parameters with all fields set
+	t.Run("Valid Parameters", func(t *testing.T) {
+		builder := groups.NewGroupRelationshipsBuilder(utils.SampleGroupID3, types.GroupRelationshipAllies).
+			WithStartRowIndex(50).
+			WithMaxRows(25)
+
+		params := builder.Build()
+		assert.Equal(t, utils.SampleGroupID3, params.GroupID)
+		assert.Equal(t, types.GroupRelationshipAllies, params.RelationshipType)
+		assert.Equal(t, int64(50), params.StartRowIndex)
+		assert.Equal(t, int64(25), params.MaxRows)
+	})
+
+	// Test case: Test pagination
+	t.Run("Test Pagination", func(t *testing.T) {
+		builder := groups.NewGroupRelationshipsBuilder(utils.SampleGroupID3, types.GroupRelationshipAllies).WithMaxRows(1)
+		relationships, err := api.GetGroupRelationships(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, relationships)
+
+		if relationships.TotalGroupCount > relationships.NextRowIndex {
+			// Fetch next page
+			builder.WithStartRowIndex(relationships.NextRowIndex)
+			nextPage, err := api.GetGroupRelationships(context.Background(), builder.Build())
+			require.NoError(t, err)
+			assert.NotNil(t, nextPage)
+			assert.NotEmpty(t, nextPage.RelatedGroups)
+		}
+	})
+}
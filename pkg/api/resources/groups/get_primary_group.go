@@ -0,0 +1,43 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetPrimaryGroup fetches the primary group and role of a specific user.
+// It returns nil without an error if the user has no primary group.
+// GET https://groups.roblox.com/v1/users/{userId}/groups/primary/role
+func (r *Resource) GetPrimaryGroup(ctx context.Context, userID int64) (*types.UserGroupRoles, error) {
+	if err := r.validate.Var(userID, "required,gt=0"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	// The endpoint responds with a JSON null when there is no primary group
+	var primaryGroup *types.UserGroupRoles
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/users/%d/groups/primary/role", types.GroupsEndpoint, userID)).
+		Result(&primaryGroup).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if primaryGroup == nil {
+		return nil, nil //nolint:nilnil // no primary group is not an error
+	}
+
+	if err := r.validate.Struct(primaryGroup); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return primaryGroup, nil
+}
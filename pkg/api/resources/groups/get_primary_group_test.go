@@ -0,0 +1,37 @@
+package groups_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/groups"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPrimaryGroup(t *testing.T) {
+	// Create a new test resource
+	api := groups.New(utils.NewTestEnv())
+
+	// Test case: Fetch primary group for a known user
+	t.Run("Fetch Known User Primary Group", func(t *testing.T) {
+		primaryGroup, err := api.GetPrimaryGroup(context.Background(), utils.SampleUserID5)
+		require.NoError(t, err)
+
+		// Users are not required to have a primary group
+		if primaryGroup != nil {
+			assert.NotZero(t, primaryGroup.Group.ID)
+			assert.NotEmpty(t, primaryGroup.Group.Name)
+			assert.NotZero(t, primaryGroup.Role.ID)
+			assert.NotEmpty(t, primaryGroup.Role.Name)
+		}
+	})
+
+	// Test case: Validate with invalid user ID
+	t.Run("Invalid User ID", func(t *testing.T) {
+		primaryGroup, err := api.GetPrimaryGroup(context.Background(), utils.InvalidUserID)
+		require.Error(t, err)
+		assert.Nil(t, primaryGroup)
+	})
+}
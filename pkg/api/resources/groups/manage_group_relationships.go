@@ -0,0 +1,64 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// SendGroupRelationshipRequest sends an ally or enemy request to another group.
+// POST https://groups.roblox.com/v1/groups/{groupId}/relationships/{groupRelationshipType}/{relatedGroupId}
+func (r *Resource) SendGroupRelationshipRequest(ctx context.Context, p GroupRelationshipActionParams) error {
+	return r.doGroupRelationshipAction(ctx, http.MethodPost, "%s/v1/groups/%d/relationships/%s/%d", p)
+}
+
+// RemoveGroupRelationship removes an existing ally or enemy relationship with another group.
+// DELETE https://groups.roblox.com/v1/groups/{groupId}/relationships/{groupRelationshipType}/{relatedGroupId}
+func (r *Resource) RemoveGroupRelationship(ctx context.Context, p GroupRelationshipActionParams) error {
+	return r.doGroupRelationshipAction(ctx, http.MethodDelete, "%s/v1/groups/%d/relationships/%s/%d", p)
+}
+
+// AcceptGroupRelationshipRequest accepts an incoming relationship request from another group.
+// POST https://groups.roblox.com/v1/groups/{groupId}/relationships/{groupRelationshipType}/requests/{relatedGroupId}
+func (r *Resource) AcceptGroupRelationshipRequest(ctx context.Context, p GroupRelationshipActionParams) error {
+	return r.doGroupRelationshipAction(ctx, http.MethodPost, "%s/v1/groups/%d/relationships/%s/requests/%d", p)
+}
+
+// DeclineGroupRelationshipRequest declines an incoming relationship request from another group.
+// DELETE https://groups.roblox.com/v1/groups/{groupId}/relationships/{groupRelationshipType}/requests/{relatedGroupId}
+func (r *Resource) DeclineGroupRelationshipRequest(ctx context.Context, p GroupRelationshipActionParams) error {
+	return r.doGroupRelationshipAction(ctx, http.MethodDelete, "%s/v1/groups/%d/relationships/%s/requests/%d", p)
+}
+
+// doGroupRelationshipAction validates the parameters and sends an authenticated relationship request.
+func (r *Resource) doGroupRelationshipAction(ctx context.Context, method, urlFormat string, p GroupRelationshipActionParams) error {
+	if err := r.validate.Struct(p); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+	ctx = context.WithValue(ctx, auth.KeyAddToken, true)
+
+	resp, err := r.client.NewRequest().
+		Method(method).
+		URL(fmt.Sprintf(urlFormat, types.GroupsEndpoint, p.GroupID, p.RelationshipType, p.RelatedGroupID)).
+		Do(ctx)
+	if err != nil {
+		return errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	return nil
+}
+
+// GroupRelationshipActionParams holds the parameters for changing a relationship between two groups.
+type GroupRelationshipActionParams struct {
+	GroupID          int64                       `json:"groupId"          validate:"required,gt=0"`
+	RelationshipType types.GroupRelationshipType `json:"relationshipType" validate:"required,oneof=Allies Enemies"`
+	RelatedGroupID   int64                       `json:"relatedGroupId"   validate:"required,gt=0,nefield=GroupID"`
+}
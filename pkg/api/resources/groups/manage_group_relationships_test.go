@@ -0,0 +1,55 @@
+package groups_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/groups"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManageGroupRelationships(t *testing.T) {
+	// Create a new test resource
+	api := groups.New(utils.NewTestEnv())
+
+	// Test case: Attempt to send a request from a group the test account does not own
+	t.Run("Send Request Without Permission", func(t *testing.T) {
+		err := api.SendGroupRelationshipRequest(context.Background(), groups.GroupRelationshipActionParams{
+			GroupID:          utils.SampleGroupID3,
+			RelationshipType: types.GroupRelationshipAllies,
+			RelatedGroupID:   utils.SampleGroupID,
+		})
+		require.Error(t, err)
+	})
+
+	// Test case: Validate with the same group on both sides
+	t.Run("Same Related Group", func(t *testing.T) {
+		err := api.RemoveGroupRelationship(context.Background(), groups.GroupRelationshipActionParams{
+			GroupID:          utils.SampleGroupID3,
+			RelationshipType: types.GroupRelationshipEnemies,
+			RelatedGroupID:   utils.SampleGroupID3,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "RelatedGroupID")
+	})
+
+	// Test case: Validate with invalid relationship type
+	t.Run("Invalid Relationship Type", func(t *testing.T) {
+		params := groups.GroupRelationshipActionParams{
+			GroupID:          utils.SampleGroupID3,
+			RelationshipType: "Friends",
+			RelatedGroupID:   utils.SampleGroupID,
+		}
+
+		err := api.AcceptGroupRelationshipRequest(context.Background(), params)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "RelationshipType")
+
+		err = api.DeclineGroupRelationshipRequest(context.Background(), params)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "RelationshipType")
+	})
+}
@@ -19,6 +19,16 @@ type ResourceInterface interface {
 	GetGroupsInfo(ctx context.Context, p GetGroupsInfoParams) (*types.GroupsInfoResponse, error)
 	GetUserGroupRoles(ctx context.Context, p UserGroupRolesParams) (*types.UserGroupRolesResponse, error)
 	GetGroupWallPosts(ctx context.Context, p GroupWallPostsParams) (*types.GroupWallPostsResponse, error)
+	GetGroupRelationships(ctx context.Context, p GroupRelationshipsParams) (*types.GroupRelationshipsResponse, error)
+	GetGroupRelationshipRequests(ctx context.Context, p GroupRelationshipsParams) (*types.GroupRelationshipsResponse, error)
+	SendGroupRelationshipRequest(ctx context.Context, p GroupRelationshipActionParams) error
+	RemoveGroupRelationship(ctx context.Context, p GroupRelationshipActionParams) error
+	AcceptGroupRelationshipRequest(ctx context.Context, p GroupRelationshipActionParams) error
+	DeclineGroupRelationshipRequest(ctx context.Context, p GroupRelationshipActionParams) error
+	GetGroupNameHistory(ctx context.Context, p GroupNameHistoryParams) (*types.GroupNameHistoryResponse, error)
+	GetPrimaryGroup(ctx context.Context, userID int64) (*types.UserGroupRoles, error)
+	GetGroupMembership(ctx context.Context, groupID int64) (*types.GroupMembershipResponse, error)
+	GetGroupPolicies(ctx context.Context, p GroupPoliciesParams) (*types.GroupPoliciesResponse, error)
 }
 
 // Ensure Resource implements the ResourceInterface.
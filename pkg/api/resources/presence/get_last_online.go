@@ -0,0 +1,38 @@
+package presence
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetLastOnline fetches the last time multiple users were online.
+// POST https://presence.roblox.com/v1/presence/last-online
+func (r *Resource) GetLastOnline(ctx context.Context, p UserPresencesParams) (*types.LastOnlineResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	var lastOnline types.LastOnlineResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(types.PresenceEndpoint + "/v1/presence/last-online").
+		MarshalBody(p).
+		Result(&lastOnline).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&lastOnline); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &lastOnline, nil
+}
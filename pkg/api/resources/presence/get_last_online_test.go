@@ -0,0 +1,35 @@
+package presence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/presence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLastOnline(t *testing.T) {
+	// Create a new test resource
+	api := presence.New(utils.NewTestEnv())
+
+	t.Run("Fetch Known Users Last Online", func(t *testing.T) {
+		userIDs := []int64{utils.SampleUserID1, utils.SampleUserID2}
+		builder := presence.NewUserPresencesBuilder(userIDs...)
+		result, err := api.GetLastOnline(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.Len(t, result.LastOnlineTimestamps, 2)
+
+		for _, lastOnline := range result.LastOnlineTimestamps {
+			assert.Contains(t, userIDs, lastOnline.UserID)
+			assert.False(t, lastOnline.LastOnline.IsZero())
+		}
+	})
+
+	t.Run("Empty User IDs", func(t *testing.T) {
+		builder := presence.NewUserPresencesBuilder()
+		_, err := api.GetLastOnline(context.Background(), builder.Build())
+		require.Error(t, err)
+	})
+}
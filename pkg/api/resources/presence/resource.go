@@ -11,6 +11,7 @@ import (
 // ResourceInterface defines the interface for presence-related operations.
 type ResourceInterface interface {
 	GetUserPresences(ctx context.Context, p UserPresencesParams) (*types.UserPresencesResponse, error)
+	GetLastOnline(ctx context.Context, p UserPresencesParams) (*types.LastOnlineResponse, error)
 }
 
 // Ensure Resource implements the ResourceInterface.
@@ -0,0 +1,329 @@
+package presence
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/roapi.go/internal/backoff"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ChangedField is a set of presence fields that changed between two polls.
+type ChangedField uint8
+
+const (
+	ChangedPresenceType ChangedField = 1 << iota
+	ChangedPlace
+	ChangedGame
+	ChangedUniverse
+)
+
+// Has reports whether all of the given fields changed.
+func (f ChangedField) Has(field ChangedField) bool {
+	return f&field == field
+}
+
+// PresenceEvent describes a change in a user's presence between two polls.
+type PresenceEvent struct {
+	UserID   int64                      // ID of the user
+	Previous types.UserPresenceResponse // Presence at the previous poll
+	Current  types.UserPresenceResponse // Presence at the latest poll
+	Changed  ChangedField               // Fields that changed
+}
+
+// Watcher polls the presence of a changeable set of users in chunks and emits an event
+// whenever a user's presence type, place, game or universe changes.
+//
+// Chunks are requested one at a time, Interval apart, so a full sweep of the watch list
+// takes roughly Interval times the number of chunks. The interval grows when requests
+// fail and shrinks back once they succeed again, and each wait is randomized by Jitter.
+type Watcher struct {
+	resource ResourceInterface
+	params   WatcherParams
+	events   chan PresenceEvent
+	errors   chan error
+
+	mu       sync.Mutex
+	userIDs  []int64            // Watched users in polling order
+	watching map[int64]struct{} // Set of the watched users, for constant-time lookups
+	previous map[int64]types.UserPresenceResponse
+	cursor   int
+}
+
+// NewWatcher creates a new Watcher that polls through the resource.
+func (r *Resource) NewWatcher(p WatcherParams) (*Watcher, error) {
+	return NewWatcher(r, r.validate, p)
+}
+
+// NewWatcher creates a new Watcher that polls through the given resource.
+// Use Resource.NewWatcher unless the resource is a custom ResourceInterface.
+func NewWatcher(resource ResourceInterface, validate *validator.Validate, p WatcherParams) (*Watcher, error) {
+	if err := validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	w := &Watcher{
+		resource: resource,
+		params:   p,
+		events:   make(chan PresenceEvent, p.BufferSize),
+		errors:   make(chan error, p.BufferSize),
+		userIDs:  make([]int64, 0, len(p.UserIDs)),
+		watching: make(map[int64]struct{}, len(p.UserIDs)),
+		previous: make(map[int64]types.UserPresenceResponse),
+		cursor:   0,
+	}
+	w.Add(p.UserIDs...)
+
+	return w, nil
+}
+
+// Events returns the channel presence changes are sent on.
+// It is closed when Run returns.
+func (w *Watcher) Events() <-chan PresenceEvent {
+	return w.events
+}
+
+// Errors returns the channel failed polls are reported on.
+// Errors are dropped when the channel is full, and it is closed when Run returns.
+func (w *Watcher) Errors() <-chan error {
+	return w.errors
+}
+
+// Add starts watching the given users. Users already being watched are ignored.
+func (w *Watcher) Add(userIDs ...int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, id := range userIDs {
+		if _, found := w.watching[id]; !found {
+			w.watching[id] = struct{}{}
+			w.userIDs = append(w.userIDs, id)
+		}
+	}
+}
+
+// Remove stops watching the given users and forgets their last known presence.
+func (w *Watcher) Remove(userIDs ...int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, id := range userIDs {
+		delete(w.watching, id)
+		delete(w.previous, id)
+	}
+
+	w.userIDs = slices.DeleteFunc(w.userIDs, func(id int64) bool {
+		_, found := w.watching[id]
+		return !found
+	})
+}
+
+// Run polls until the context is done. It must only be called once.
+// The first poll of a user records its presence without emitting an event.
+func (w *Watcher) Run(ctx context.Context) error {
+	defer close(w.events)
+	defer close(w.errors)
+
+	delays := backoff.New(w.params.Interval, w.params.MaxInterval, w.params.Jitter)
+
+	for {
+		if chunk := w.nextChunk(); len(chunk) > 0 {
+			presences, err := w.resource.GetUserPresences(ctx, UserPresencesParams{UserIDs: chunk})
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+
+				select {
+				case w.errors <- err:
+				default:
+				}
+
+				// Back off to stay inside rate limits
+				delays.Increase()
+			} else {
+				if err := w.emit(ctx, presences.UserPresences); err != nil {
+					return err
+				}
+
+				// Recover gradually towards the configured interval
+				delays.Decrease()
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delays.Delay()):
+		}
+	}
+}
+
+// nextChunk returns the next chunk of users to poll, wrapping around the watch list.
+func (w *Watcher) nextChunk() []int64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.userIDs) == 0 {
+		return nil
+	}
+
+	if w.cursor >= len(w.userIDs) {
+		w.cursor = 0
+	}
+
+	end := min(w.cursor+w.params.ChunkSize, len(w.userIDs))
+	chunk := slices.Clone(w.userIDs[w.cursor:end])
+	w.cursor = end
+
+	return chunk
+}
+
+// emit compares the polled presences with the previous ones and sends an event for each change.
+func (w *Watcher) emit(ctx context.Context, presences []types.UserPresenceResponse) error {
+	events := make([]PresenceEvent, 0)
+
+	w.mu.Lock()
+
+	for _, current := range presences {
+		// Skip users that were removed while the request was in flight
+		if _, found := w.watching[current.UserID]; !found {
+			continue
+		}
+
+		previous, found := w.previous[current.UserID]
+		w.previous[current.UserID] = current
+
+		if !found {
+			continue
+		}
+
+		if changed := diffPresence(previous, current); changed != 0 {
+			events = append(events, PresenceEvent{
+				UserID:   current.UserID,
+				Previous: previous,
+				Current:  current,
+				Changed:  changed,
+			})
+		}
+	}
+
+	w.mu.Unlock()
+
+	for _, event := range events {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case w.events <- event:
+		}
+	}
+
+	return nil
+}
+
+// diffPresence returns the fields that differ between two presences.
+func diffPresence(previous, current types.UserPresenceResponse) ChangedField {
+	var changed ChangedField
+
+	if previous.UserPresenceType != current.UserPresenceType {
+		changed |= ChangedPresenceType
+	}
+
+	if !equalPtr(previous.PlaceID, current.PlaceID) {
+		changed |= ChangedPlace
+	}
+
+	if !equalPtr(previous.GameID, current.GameID) {
+		changed |= ChangedGame
+	}
+
+	if !equalPtr(previous.UniverseID, current.UniverseID) {
+		changed |= ChangedUniverse
+	}
+
+	return changed
+}
+
+// equalPtr reports whether two optional values are both absent or both equal.
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// WatcherParams holds the parameters for watching user presences.
+type WatcherParams struct {
+	UserIDs     []int64       `json:"userIds"     validate:"dive,gt=0"`
+	ChunkSize   int           `json:"chunkSize"   validate:"min=1,max=50"`
+	Interval    time.Duration `json:"interval"    validate:"gt=0"`
+	MaxInterval time.Duration `json:"maxInterval" validate:"gtefield=Interval"`
+	Jitter      float64       `json:"jitter"      validate:"min=0,max=1"`
+	BufferSize  int           `json:"bufferSize"  validate:"min=0"`
+}
+
+// WatcherBuilder is a builder for WatcherParams.
+type WatcherBuilder struct {
+	params WatcherParams
+}
+
+// NewWatcherBuilder creates a new WatcherBuilder with default values.
+func NewWatcherBuilder(userIDs ...int64) *WatcherBuilder {
+	return &WatcherBuilder{
+		params: WatcherParams{
+			UserIDs:     userIDs,
+			ChunkSize:   50,
+			Interval:    5 * time.Second,
+			MaxInterval: 2 * time.Minute,
+			Jitter:      0.2,
+			BufferSize:  100,
+		},
+	}
+}
+
+// WithUserIDs adds multiple user IDs to the initial watch list.
+func (b *WatcherBuilder) WithUserIDs(userIDs ...int64) *WatcherBuilder {
+	b.params.UserIDs = append(b.params.UserIDs, userIDs...)
+	return b
+}
+
+// WithChunkSize sets how many users are polled per request.
+func (b *WatcherBuilder) WithChunkSize(chunkSize int) *WatcherBuilder {
+	b.params.ChunkSize = chunkSize
+	return b
+}
+
+// WithInterval sets the delay between requests when they succeed.
+func (b *WatcherBuilder) WithInterval(interval time.Duration) *WatcherBuilder {
+	b.params.Interval = interval
+	return b
+}
+
+// WithMaxInterval sets the longest delay between requests after repeated failures.
+func (b *WatcherBuilder) WithMaxInterval(maxInterval time.Duration) *WatcherBuilder {
+	b.params.MaxInterval = maxInterval
+	return b
+}
+
+// WithJitter sets the fraction each delay is shortened by at random, between 0 and 1.
+func (b *WatcherBuilder) WithJitter(jitter float64) *WatcherBuilder {
+	b.params.Jitter = jitter
+	return b
+}
+
+// WithBufferSize sets the capacity of the event and error channels.
+func (b *WatcherBuilder) WithBufferSize(bufferSize int) *WatcherBuilder {
+	b.params.BufferSize = bufferSize
+	return b
+}
+
+// Build returns the WatcherParams.
+func (b *WatcherBuilder) Build() WatcherParams {
+	return b.params
+}
@@ -0,0 +1,180 @@
+package presence_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/resources/presence"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errRateLimited = errors.New("rate limited")
+
+// fakePresences serves presences from memory and records the chunks it was asked for.
+type fakePresences struct {
+	mu        sync.Mutex
+	presences map[int64]types.UserPresenceResponse
+	chunks    [][]int64
+	fail      bool
+}
+
+func (f *fakePresences) GetUserPresences(_ context.Context, p presence.UserPresencesParams) (*types.UserPresencesResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.chunks = append(f.chunks, p.UserIDs)
+	if f.fail {
+		return nil, errRateLimited
+	}
+
+	result := &types.UserPresencesResponse{}
+	for _, id := range p.UserIDs {
+		result.UserPresences = append(result.UserPresences, f.presences[id])
+	}
+
+	return result, nil
+}
+
+func (f *fakePresences) GetLastOnline(context.Context, presence.UserPresencesParams) (*types.LastOnlineResponse, error) {
+	return &types.LastOnlineResponse{}, nil
+}
+
+func (f *fakePresences) set(p types.UserPresenceResponse) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.presences[p.UserID] = p
+}
+
+func TestWatcher(t *testing.T) {
+	v := validator.New(validator.WithRequiredStructEnabled())
+
+	newFake := func(userIDs ...int64) *fakePresences {
+		f := &fakePresences{presences: make(map[int64]types.UserPresenceResponse)}
+		for _, id := range userIDs {
+			f.presences[id] = types.UserPresenceResponse{UserID: id, UserPresenceType: types.Offline}
+		}
+
+		return f
+	}
+
+	t.Run("Emit Presence Changes", func(t *testing.T) {
+		fake := newFake(1, 2)
+		params := presence.NewWatcherBuilder(1, 2).
+			WithInterval(time.Millisecond).
+			WithMaxInterval(10 * time.Millisecond).
+			WithJitter(0).
+			Build()
+
+		watcher, err := presence.NewWatcher(fake, v, params)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		go func() { _ = watcher.Run(ctx) }()
+
+		// Let the watcher record the initial presences before changing one
+		time.Sleep(20 * time.Millisecond)
+
+		placeID := int64(10)
+		universeID := int64(20)
+		gameID := "game"
+		fake.set(types.UserPresenceResponse{
+			UserID:           2,
+			UserPresenceType: types.InGame,
+			PlaceID:          &placeID,
+			UniverseID:       &universeID,
+			GameID:           &gameID,
+		})
+
+		select {
+		case event := <-watcher.Events():
+			assert.Equal(t, int64(2), event.UserID)
+			assert.Equal(t, types.Offline, event.Previous.UserPresenceType)
+			assert.Equal(t, types.InGame, event.Current.UserPresenceType)
+			assert.True(t, event.Changed.Has(presence.ChangedPresenceType|presence.ChangedPlace))
+			assert.True(t, event.Changed.Has(presence.ChangedGame|presence.ChangedUniverse))
+		case <-ctx.Done():
+			t.Fatal("no presence event received")
+		}
+	})
+
+	t.Run("Poll In Chunks", func(t *testing.T) {
+		fake := newFake(1, 2, 3, 4, 5)
+		params := presence.NewWatcherBuilder(1, 2, 3).
+			WithChunkSize(2).
+			WithInterval(time.Millisecond).
+			WithJitter(0).
+			Build()
+
+		watcher, err := presence.NewWatcher(fake, v, params)
+		require.NoError(t, err)
+
+		watcher.Add(4, 5, 1)
+		watcher.Remove(2)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_ = watcher.Run(ctx)
+
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+
+		require.GreaterOrEqual(t, len(fake.chunks), 2)
+		assert.Equal(t, []int64{1, 3}, fake.chunks[0])
+		assert.Equal(t, []int64{4, 5}, fake.chunks[1])
+	})
+
+	t.Run("Report Errors And Back Off", func(t *testing.T) {
+		fake := newFake(1)
+		fake.fail = true
+
+		params := presence.NewWatcherBuilder(1).
+			WithInterval(time.Millisecond).
+			WithMaxInterval(time.Second).
+			WithJitter(0).
+			Build()
+
+		watcher, err := presence.NewWatcher(fake, v, params)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		_ = watcher.Run(ctx)
+
+		err = <-watcher.Errors()
+		require.ErrorIs(t, err, errRateLimited)
+
+		// Doubling from 1ms, 100ms only leaves room for a handful of attempts
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+		assert.Less(t, len(fake.chunks), 10)
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		params := presence.NewWatcherBuilder(1).WithChunkSize(100).Build()
+		_, err := presence.NewWatcher(newFake(), v, params)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ChunkSize")
+
+		params = presence.NewWatcherBuilder(1).WithInterval(time.Minute).WithMaxInterval(time.Second).Build()
+		_, err = presence.NewWatcher(newFake(), v, params)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "MaxInterval")
+
+		resource := presence.New(client.NewClient(), v)
+		_, err = resource.NewWatcher(presence.NewWatcherBuilder(0).Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "UserIDs")
+	})
+}
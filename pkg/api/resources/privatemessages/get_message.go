@@ -0,0 +1,65 @@
+package privatemessages
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetMessage fetches a single private message of the authenticated user.
+// GET https://privatemessages.roblox.com/v1/messages/{messageId}
+func (r *Resource) GetMessage(ctx context.Context, messageID int64) (*types.Message, error) {
+	if err := r.validate.Var(messageID, "required,gt=0"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+
+	var message types.Message
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/messages/%d", types.PrivateMessagesEndpoint, messageID)).
+		Result(&message).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&message); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &message, nil
+}
+
+// GetUnreadCount fetches the number of unread private messages of the authenticated user.
+// GET https://privatemessages.roblox.com/v1/messages/unread/count
+func (r *Resource) GetUnreadCount(ctx context.Context) (*types.UnreadMessagesCountResponse, error) {
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+
+	var count types.UnreadMessagesCountResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(types.PrivateMessagesEndpoint + "/v1/messages/unread/count").
+		Result(&count).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&count); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &count, nil
+}
@@ -0,0 +1,48 @@
+package privatemessages_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/privatemessages"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMessage(t *testing.T) {
+	// Create a new test resource
+	api := privatemessages.New(utils.NewTestEnv())
+
+	t.Run("Fetch First Inbox Message", func(t *testing.T) {
+		builder := privatemessages.NewMessagesBuilder(types.MessageTabInbox).WithPageSize(1)
+		page, err := api.GetMessages(context.Background(), builder.Build())
+		require.NoError(t, err)
+
+		if len(page.Collection) == 0 {
+			t.Skip("inbox is empty")
+		}
+
+		message, err := api.GetMessage(context.Background(), page.Collection[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, page.Collection[0].ID, message.ID)
+		assert.Equal(t, page.Collection[0].Sender.ID, message.Sender.ID)
+	})
+
+	t.Run("Invalid Message ID", func(t *testing.T) {
+		_, err := api.GetMessage(context.Background(), 0)
+		require.Error(t, err)
+	})
+}
+
+func TestGetUnreadCount(t *testing.T) {
+	// Create a new test resource
+	api := privatemessages.New(utils.NewTestEnv())
+
+	t.Run("Fetch Unread Count Successfully", func(t *testing.T) {
+		result, err := api.GetUnreadCount(context.Background())
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, result.Count, int64(0))
+	})
+}
@@ -0,0 +1,112 @@
+package privatemessages
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetMessages fetches a page of the authenticated user's private messages in the given folder.
+// GET https://privatemessages.roblox.com/v1/messages
+func (r *Resource) GetMessages(ctx context.Context, p MessagesParams) (*types.MessagesPageResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+
+	var messages types.MessagesPageResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(types.PrivateMessagesEndpoint+"/v1/messages").
+		Query("messageTab", string(p.Tab)).
+		Query("pageNumber", strconv.FormatInt(p.PageNumber, 10)).
+		Query("pageSize", strconv.FormatInt(p.PageSize, 10)).
+		Result(&messages).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&messages); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &messages, nil
+}
+
+// GetMessagesAll returns an iterator over every message in the given folder,
+// starting at the page in the parameters and following pages until the last one.
+// Iteration stops at the first error, which is yielded with a nil message.
+func (r *Resource) GetMessagesAll(ctx context.Context, p MessagesParams) iter.Seq2[*types.Message, error] {
+	return func(yield func(*types.Message, error) bool) {
+		for {
+			result, err := r.GetMessages(ctx, p)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for i := range result.Collection {
+				if !yield(&result.Collection[i], nil) {
+					return
+				}
+			}
+
+			if len(result.Collection) == 0 || p.PageNumber+1 >= result.TotalPages {
+				return
+			}
+
+			p.PageNumber++
+		}
+	}
+}
+
+// MessagesParams holds the parameters for listing private messages.
+type MessagesParams struct {
+	Tab        types.MessageTab `json:"messageTab" validate:"required,oneof=Inbox Sent Archive"`
+	PageNumber int64            `json:"pageNumber" validate:"min=0"`
+	PageSize   int64            `json:"pageSize"   validate:"min=1,max=100"`
+}
+
+// MessagesBuilder is a builder for MessagesParams.
+type MessagesBuilder struct {
+	params MessagesParams
+}
+
+// NewMessagesBuilder creates a new MessagesBuilder with default values.
+func NewMessagesBuilder(tab types.MessageTab) *MessagesBuilder {
+	return &MessagesBuilder{
+		params: MessagesParams{
+			Tab:        tab,
+			PageNumber: 0,
+			PageSize:   20,
+		},
+	}
+}
+
+// WithPageNumber sets the zero-based page number.
+func (b *MessagesBuilder) WithPageNumber(pageNumber int64) *MessagesBuilder {
+	b.params.PageNumber = pageNumber
+	return b
+}
+
+// WithPageSize sets the number of messages per page.
+func (b *MessagesBuilder) WithPageSize(pageSize int64) *MessagesBuilder {
+	b.params.PageSize = pageSize
+	return b
+}
+
+// Build returns the MessagesParams.
+func (b *MessagesBuilder) Build() MessagesParams {
+	return b.params
+}
@@ -0,0 +1,65 @@
+package privatemessages_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/privatemessages"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMessages(t *testing.T) {
+	// Create a new test resource
+	api := privatemessages.New(utils.NewTestEnv())
+
+	t.Run("Fetch Inbox Successfully", func(t *testing.T) {
+		builder := privatemessages.NewMessagesBuilder(types.MessageTabInbox)
+		result, err := api.GetMessages(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, int64(0), result.PageNumber)
+		assert.LessOrEqual(t, len(result.Collection), 20)
+
+		for _, message := range result.Collection {
+			assert.NotZero(t, message.ID)
+			assert.NotZero(t, message.Recipient.ID)
+			assert.False(t, message.Created.IsZero())
+		}
+	})
+
+	t.Run("Iterate Sent Messages", func(t *testing.T) {
+		builder := privatemessages.NewMessagesBuilder(types.MessageTabSent).WithPageSize(5)
+
+		count := 0
+		for message, err := range api.GetMessagesAll(context.Background(), builder.Build()) {
+			require.NoError(t, err)
+			assert.NotZero(t, message.ID)
+
+			count++
+			if count == 10 {
+				break
+			}
+		}
+	})
+
+	t.Run("Invalid Tab", func(t *testing.T) {
+		builder := privatemessages.NewMessagesBuilder("Drafts")
+		_, err := api.GetMessages(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Tab")
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := privatemessages.NewMessagesBuilder(types.MessageTabArchive).
+			WithPageNumber(2).
+			WithPageSize(50)
+
+		params := builder.Build()
+		assert.Equal(t, types.MessageTabArchive, params.Tab)
+		assert.Equal(t, int64(2), params.PageNumber)
+		assert.Equal(t, int64(50), params.PageSize)
+	})
+}
@@ -0,0 +1,40 @@
+package privatemessages
+
+import (
+	"context"
+	"iter"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ResourceInterface defines the interface for private message-related operations.
+type ResourceInterface interface {
+	GetMessages(ctx context.Context, p MessagesParams) (*types.MessagesPageResponse, error)
+	GetMessagesAll(ctx context.Context, p MessagesParams) iter.Seq2[*types.Message, error]
+	GetMessage(ctx context.Context, messageID int64) (*types.Message, error)
+	GetUnreadCount(ctx context.Context) (*types.UnreadMessagesCountResponse, error)
+	SendMessage(ctx context.Context, p SendMessageParams) (*types.SendMessageResponse, error)
+	MarkRead(ctx context.Context, messageIDs []int64) (*types.MessageBatchResponse, error)
+	MarkUnread(ctx context.Context, messageIDs []int64) (*types.MessageBatchResponse, error)
+	Archive(ctx context.Context, messageIDs []int64) (*types.MessageBatchResponse, error)
+	Unarchive(ctx context.Context, messageIDs []int64) (*types.MessageBatchResponse, error)
+}
+
+// Ensure Resource implements the ResourceInterface.
+var _ ResourceInterface = (*Resource)(nil)
+
+// Resource provides methods for interacting with private message-related endpoints.
+type Resource struct {
+	client   *client.Client
+	validate *validator.Validate
+}
+
+// New creates a new Resource with the specified client and validator.
+func New(client *client.Client, validate *validator.Validate) *Resource {
+	return &Resource{
+		client:   client,
+		validate: validate,
+	}
+}
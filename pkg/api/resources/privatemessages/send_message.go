@@ -0,0 +1,126 @@
+package privatemessages
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ErrSendFailed is returned when the send endpoint accepts a request but reports it as unsuccessful.
+var ErrSendFailed = errors.New("private message was not sent")
+
+// SendMessage sends a private message, or a reply if ReplyMessageID is set.
+// If Roblox rejects the message, the response is returned along with an error wrapping ErrSendFailed.
+// POST https://privatemessages.roblox.com/v1/messages/send
+func (r *Resource) SendMessage(ctx context.Context, p SendMessageParams) (*types.SendMessageResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+	ctx = context.WithValue(ctx, auth.KeyAddToken, true)
+
+	var result types.SendMessageResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(types.PrivateMessagesEndpoint + "/v1/messages/send").
+		MarshalBody(p).
+		Result(&result).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if !result.Success {
+		return &result, fmt.Errorf("%w: %s", ErrSendFailed, result.Message)
+	}
+
+	return &result, nil
+}
+
+// SendMessageParams holds the parameters for sending a private message.
+type SendMessageParams struct {
+	UserID                 int64  `json:"userId"                 validate:"required,gt=0"`     // ID of the authenticated user sending the message
+	RecipientID            int64  `json:"recipientId"            validate:"required,gt=0"`     // ID of the user receiving the message
+	Subject                string `json:"subject"                validate:"required,max=200"`  // Subject of the message
+	Body                   string `json:"body"                   validate:"required,max=5000"` // Body of the message
+	ReplyMessageID         *int64 `json:"replyMessageId"         validate:"omitempty,gt=0"`    // ID of the message being replied to
+	IncludePreviousMessage bool   `json:"includePreviousMessage"`                              // Whether to quote the message being replied to
+}
+
+// SendMessageBuilder is a builder for SendMessageParams.
+type SendMessageBuilder struct {
+	params SendMessageParams
+}
+
+// NewSendMessageBuilder creates a new SendMessageBuilder for a new conversation.
+func NewSendMessageBuilder(userID, recipientID int64, subject, body string) *SendMessageBuilder {
+	return &SendMessageBuilder{
+		params: SendMessageParams{
+			UserID:                 userID,
+			RecipientID:            recipientID,
+			Subject:                subject,
+			Body:                   body,
+			ReplyMessageID:         nil,
+			IncludePreviousMessage: false,
+		},
+	}
+}
+
+// NewReplyMessageBuilder creates a new SendMessageBuilder that replies to the given message.
+// The reply goes to the other participant of the message, its subject is prefixed with "RE: "
+// and the original message is quoted.
+func NewReplyMessageBuilder(userID int64, message *types.Message, body string) *SendMessageBuilder {
+	recipientID := message.Sender.ID
+	if recipientID == userID {
+		recipientID = message.Recipient.ID
+	}
+
+	subject := message.Subject
+	if !strings.HasPrefix(subject, "RE: ") {
+		subject = "RE: " + subject
+	}
+
+	return &SendMessageBuilder{
+		params: SendMessageParams{
+			UserID:                 userID,
+			RecipientID:            recipientID,
+			Subject:                subject,
+			Body:                   body,
+			ReplyMessageID:         &message.ID,
+			IncludePreviousMessage: true,
+		},
+	}
+}
+
+// WithSubject sets the subject.
+func (b *SendMessageBuilder) WithSubject(subject string) *SendMessageBuilder {
+	b.params.Subject = subject
+	return b
+}
+
+// WithReplyTo sets the message being replied to.
+func (b *SendMessageBuilder) WithReplyTo(messageID int64) *SendMessageBuilder {
+	b.params.ReplyMessageID = &messageID
+	return b
+}
+
+// WithIncludePreviousMessage sets whether the message being replied to is quoted.
+func (b *SendMessageBuilder) WithIncludePreviousMessage(include bool) *SendMessageBuilder {
+	b.params.IncludePreviousMessage = include
+	return b
+}
+
+// Build returns the SendMessageParams.
+func (b *SendMessageBuilder) Build() SendMessageParams {
+	return b.params
+}
@@ -0,0 +1,70 @@
+package privatemessages_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/privatemessages"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendMessage(t *testing.T) {
+	// Create a new test resource
+	api := privatemessages.New(utils.NewTestEnv())
+
+	t.Run("Missing Body", func(t *testing.T) {
+		builder := privatemessages.NewSendMessageBuilder(utils.SampleUserID1, utils.SampleUserID2, "Hello", "")
+		_, err := api.SendMessage(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Body")
+	})
+
+	t.Run("Invalid Recipient", func(t *testing.T) {
+		builder := privatemessages.NewSendMessageBuilder(utils.SampleUserID1, 0, "Hello", "World")
+		_, err := api.SendMessage(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "RecipientID")
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := privatemessages.NewSendMessageBuilder(utils.SampleUserID1, utils.SampleUserID2, "Hello", "World").
+			WithSubject("Greetings").
+			WithReplyTo(123).
+			WithIncludePreviousMessage(true)
+
+		params := builder.Build()
+		assert.Equal(t, int64(utils.SampleUserID1), params.UserID)
+		assert.Equal(t, int64(utils.SampleUserID2), params.RecipientID)
+		assert.Equal(t, "Greetings", params.Subject)
+		assert.Equal(t, "World", params.Body)
+		require.NotNil(t, params.ReplyMessageID)
+		assert.Equal(t, int64(123), *params.ReplyMessageID)
+		assert.True(t, params.IncludePreviousMessage)
+	})
+
+	t.Run("Test Reply Builder", func(t *testing.T) {
+		message := &types.Message{
+			ID:        456,
+			Sender:    types.VerifiedBadgeUser{ID: utils.SampleUserID2},
+			Recipient: types.VerifiedBadgeUser{ID: utils.SampleUserID1},
+			Subject:   "Question",
+			Created:   time.Now(),
+		}
+
+		params := privatemessages.NewReplyMessageBuilder(utils.SampleUserID1, message, "Answer").Build()
+		assert.Equal(t, int64(utils.SampleUserID2), params.RecipientID)
+		assert.Equal(t, "RE: Question", params.Subject)
+		require.NotNil(t, params.ReplyMessageID)
+		assert.Equal(t, int64(456), *params.ReplyMessageID)
+		assert.True(t, params.IncludePreviousMessage)
+
+		// Replying to a reply keeps a single prefix
+		message.Subject = params.Subject
+		params = privatemessages.NewReplyMessageBuilder(utils.SampleUserID1, message, "Again").Build()
+		assert.Equal(t, "RE: Question", params.Subject)
+	})
+}
@@ -0,0 +1,83 @@
+package privatemessages
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// MaxBatchSize is the largest number of messages that can be updated in a single request.
+// Larger batches are split into several requests.
+const MaxBatchSize = 20
+
+// MarkRead marks private messages as read.
+// POST https://privatemessages.roblox.com/v1/messages/mark-read
+func (r *Resource) MarkRead(ctx context.Context, messageIDs []int64) (*types.MessageBatchResponse, error) {
+	return r.updateMessages(ctx, "mark-read", messageIDs)
+}
+
+// MarkUnread marks private messages as unread.
+// POST https://privatemessages.roblox.com/v1/messages/mark-unread
+func (r *Resource) MarkUnread(ctx context.Context, messageIDs []int64) (*types.MessageBatchResponse, error) {
+	return r.updateMessages(ctx, "mark-unread", messageIDs)
+}
+
+// Archive moves private messages to the archive.
+// POST https://privatemessages.roblox.com/v1/messages/archive
+func (r *Resource) Archive(ctx context.Context, messageIDs []int64) (*types.MessageBatchResponse, error) {
+	return r.updateMessages(ctx, "archive", messageIDs)
+}
+
+// Unarchive moves private messages out of the archive.
+// POST https://privatemessages.roblox.com/v1/messages/unarchive
+func (r *Resource) Unarchive(ctx context.Context, messageIDs []int64) (*types.MessageBatchResponse, error) {
+	return r.updateMessages(ctx, "unarchive", messageIDs)
+}
+
+// updateMessages applies an action to the messages in batches of MaxBatchSize
+// and combines the failed messages of every batch.
+func (r *Resource) updateMessages(ctx context.Context, action string, messageIDs []int64) (*types.MessageBatchResponse, error) {
+	if err := r.validate.Var(messageIDs, "required,min=1,unique,dive,gt=0"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+	ctx = context.WithValue(ctx, auth.KeyAddToken, true)
+
+	combined := types.MessageBatchResponse{FailedMessages: make([]types.FailedMessage, 0)}
+
+	for start := 0; start < len(messageIDs); start += MaxBatchSize {
+		batch := messageIDs[start:min(start+MaxBatchSize, len(messageIDs))]
+
+		var result types.MessageBatchResponse
+
+		resp, err := r.client.NewRequest().
+			Method(http.MethodPost).
+			URL(fmt.Sprintf("%s/v1/messages/%s", types.PrivateMessagesEndpoint, action)).
+			MarshalBody(MessageBatchParams{MessageIDs: batch}).
+			Result(&result).
+			Do(ctx)
+		if err != nil {
+			return nil, errs.HandleAPIError(resp, err)
+		}
+
+		_ = resp.Body.Close()
+
+		if err := r.validate.Struct(&result); err != nil {
+			return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+		}
+
+		combined.FailedMessages = append(combined.FailedMessages, result.FailedMessages...)
+	}
+
+	return &combined, nil
+}
+
+// MessageBatchParams holds the body of a batch message update.
+type MessageBatchParams struct {
+	MessageIDs []int64 `json:"messageIds" validate:"required,min=1,max=20,dive,gt=0"`
+}
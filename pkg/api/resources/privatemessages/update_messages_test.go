@@ -0,0 +1,57 @@
+package privatemessages_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/privatemessages"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateMessages(t *testing.T) {
+	// Create a new test resource
+	api := privatemessages.New(utils.NewTestEnv())
+
+	t.Run("Mark Read And Unread", func(t *testing.T) {
+		builder := privatemessages.NewMessagesBuilder(types.MessageTabInbox).WithPageSize(1)
+		page, err := api.GetMessages(context.Background(), builder.Build())
+		require.NoError(t, err)
+
+		if len(page.Collection) == 0 {
+			t.Skip("inbox is empty")
+		}
+
+		message := page.Collection[0]
+		ids := []int64{message.ID}
+
+		result, err := api.MarkUnread(context.Background(), ids)
+		require.NoError(t, err)
+		assert.Empty(t, result.FailedMessages)
+
+		result, err = api.MarkRead(context.Background(), ids)
+		require.NoError(t, err)
+		assert.Empty(t, result.FailedMessages)
+
+		// Restore the original state
+		if !message.IsRead {
+			_, err = api.MarkUnread(context.Background(), ids)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("Empty Message IDs", func(t *testing.T) {
+		_, err := api.Archive(context.Background(), []int64{})
+		require.Error(t, err)
+	})
+
+	t.Run("Invalid Message IDs", func(t *testing.T) {
+		_, err := api.Unarchive(context.Background(), []int64{1, 0})
+		require.Error(t, err)
+
+		_, err = api.MarkRead(context.Background(), []int64{1, 1})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,112 @@
+package sessions
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetSessions fetches a page of the active sessions of the cookie's account.
+// GET https://apis.roblox.com/token-metadata-service/v1/sessions
+func (r *Resource) GetSessions(ctx context.Context, p SessionsParams) (*types.SessionsResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+	ctx = context.WithValue(ctx, auth.KeyUseCookie, p.Cookie)
+
+	var sessions types.SessionsResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(types.ApisEndpoint+"/token-metadata-service/v1/sessions").
+		Query("nextCursor", p.Cursor).
+		Query("desiredLimit", strconv.FormatInt(p.Limit, 10)).
+		Result(&sessions).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&sessions); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &sessions, nil
+}
+
+// GetSessionsAll returns an iterator over every active session of the cookie's account,
+// starting at the cursor in the parameters and following cursors until the last page.
+// Iteration stops at the first error, which is yielded with a nil session.
+func (r *Resource) GetSessionsAll(ctx context.Context, p SessionsParams) iter.Seq2[*types.Session, error] {
+	return func(yield func(*types.Session, error) bool) {
+		for {
+			result, err := r.GetSessions(ctx, p)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for i := range result.Sessions {
+				if !yield(&result.Sessions[i], nil) {
+					return
+				}
+			}
+
+			if len(result.Sessions) == 0 || result.NextCursor == "" || result.NextCursor == p.Cursor {
+				return
+			}
+
+			p.Cursor = result.NextCursor
+		}
+	}
+}
+
+// SessionsParams holds the parameters for listing sessions.
+type SessionsParams struct {
+	Cookie string `json:"-"            validate:"required"`      // Cookie of the account to list the sessions of
+	Cursor string `json:"nextCursor"`                            // Cursor of the page to fetch
+	Limit  int64  `json:"desiredLimit" validate:"min=1,max=100"` // Number of sessions per page
+}
+
+// SessionsBuilder is a builder for SessionsParams.
+type SessionsBuilder struct {
+	params SessionsParams
+}
+
+// NewSessionsBuilder creates a new SessionsBuilder with default values.
+func NewSessionsBuilder(cookie string) *SessionsBuilder {
+	return &SessionsBuilder{
+		params: SessionsParams{
+			Cookie: cookie,
+			Cursor: "",
+			Limit:  25,
+		},
+	}
+}
+
+// WithCursor sets the cursor of the page to fetch.
+func (b *SessionsBuilder) WithCursor(cursor string) *SessionsBuilder {
+	b.params.Cursor = cursor
+	return b
+}
+
+// WithLimit sets the number of sessions per page.
+func (b *SessionsBuilder) WithLimit(limit int64) *SessionsBuilder {
+	b.params.Limit = limit
+	return b
+}
+
+// Build returns the SessionsParams.
+func (b *SessionsBuilder) Build() SessionsParams {
+	return b.params
+}
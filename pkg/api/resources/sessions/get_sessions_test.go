@@ -0,0 +1,73 @@
+package sessions_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/sessions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSessions(t *testing.T) {
+	// Create a new test resource
+	client, validate := utils.NewTestEnv()
+	api := sessions.New(client, validate, nil)
+	cookie := os.Getenv("ROAPI_COOKIE")
+
+	t.Run("Fetch Sessions Successfully", func(t *testing.T) {
+		builder := sessions.NewSessionsBuilder(cookie)
+		result, err := api.GetSessions(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotEmpty(t, result.Sessions)
+
+		current := 0
+		for _, session := range result.Sessions {
+			assert.NotEmpty(t, session.Token)
+
+			if session.IsCurrentSession {
+				current++
+			}
+		}
+
+		assert.LessOrEqual(t, current, 1)
+	})
+
+	t.Run("Iterate Sessions", func(t *testing.T) {
+		builder := sessions.NewSessionsBuilder(cookie).WithLimit(5)
+
+		count := 0
+		for session, err := range api.GetSessionsAll(context.Background(), builder.Build()) {
+			require.NoError(t, err)
+			assert.NotEmpty(t, session.Token)
+
+			count++
+			if count == 10 {
+				break
+			}
+		}
+
+		assert.Positive(t, count)
+	})
+
+	t.Run("Missing Cookie", func(t *testing.T) {
+		builder := sessions.NewSessionsBuilder("")
+		_, err := api.GetSessions(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Cookie")
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := sessions.NewSessionsBuilder("cookie").
+			WithCursor("cursor").
+			WithLimit(50)
+
+		params := builder.Build()
+		assert.Equal(t, "cookie", params.Cookie)
+		assert.Equal(t, "cursor", params.Cursor)
+		assert.Equal(t, int64(50), params.Limit)
+	})
+}
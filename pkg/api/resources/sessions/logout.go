@@ -0,0 +1,69 @@
+package sessions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ErrCookieNotFound is returned when a refreshed session does not come with a new cookie.
+var ErrCookieNotFound = errors.New(".ROBLOSECURITY cookie not found in response")
+
+// SignOutOtherSessions ends every other session of the cookie's account and returns the
+// fresh cookie that replaces the given one, which stops working.
+// The cookie is replaced in the cookie store as well.
+// POST https://auth.roblox.com/v2/logoutfromallsessionsandreauthenticate
+func (r *Resource) SignOutOtherSessions(ctx context.Context, cookie string) (string, error) {
+	if err := r.validate.Var(cookie, "required"); err != nil {
+		return "", fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	resp, err := r.post(ctx, cookie, types.AuthEndpoint+"/v2/logoutfromallsessionsandreauthenticate", struct{}{})
+	if err != nil {
+		return "", err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	newCookie := ""
+
+	for _, c := range resp.Cookies() {
+		if c.Name == ".ROBLOSECURITY" && c.Value != "" {
+			newCookie = c.Value
+		}
+	}
+
+	if newCookie == "" {
+		return "", ErrCookieNotFound
+	}
+
+	if r.cookies != nil {
+		r.cookies.ReplaceCookie(cookie, newCookie)
+	}
+
+	return newCookie, nil
+}
+
+// Logout ends the cookie's session and removes the cookie from the cookie store.
+// POST https://auth.roblox.com/v2/logout
+func (r *Resource) Logout(ctx context.Context, cookie string) error {
+	if err := r.validate.Var(cookie, "required"); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	resp, err := r.post(ctx, cookie, types.AuthEndpoint+"/v2/logout", struct{}{})
+	if err != nil {
+		return err
+	}
+
+	_ = resp.Body.Close()
+
+	if r.cookies != nil {
+		r.cookies.RemoveCookie(cookie)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package sessions
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/internal/csrf"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+)
+
+// post sends a JSON request as the given cookie. The cached CSRF token of the auth middleware
+// may belong to another cookie, so if the server rejects it with a new token, the request is
+// sent once more with that token.
+func (r *Resource) post(ctx context.Context, cookie, url string, body any) (*http.Response, error) {
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+	ctx = context.WithValue(ctx, auth.KeyUseCookie, cookie)
+	ctx = context.WithValue(ctx, auth.KeyAddToken, true)
+
+	var token csrf.Token
+
+	return csrf.Post(ctx, r.client, &token, url, body, nil)
+}
@@ -0,0 +1,47 @@
+package sessions
+
+import (
+	"context"
+	"iter"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ResourceInterface defines the interface for session-related operations.
+type ResourceInterface interface {
+	GetSessions(ctx context.Context, p SessionsParams) (*types.SessionsResponse, error)
+	GetSessionsAll(ctx context.Context, p SessionsParams) iter.Seq2[*types.Session, error]
+	RevokeSession(ctx context.Context, p RevokeSessionParams) error
+	SignOutOtherSessions(ctx context.Context, cookie string) (string, error)
+	Logout(ctx context.Context, cookie string) error
+}
+
+// Ensure Resource implements the ResourceInterface.
+var _ ResourceInterface = (*Resource)(nil)
+
+// CookieStore holds the cookies requests are made with, so that they can be kept
+// up to date when a session is refreshed or ended. *auth.Middleware implements it.
+type CookieStore interface {
+	ReplaceCookie(oldCookie, newCookie string) bool
+	RemoveCookie(cookie string) bool
+}
+
+// Resource provides methods for interacting with session-related endpoints.
+// Every method acts on the session of an explicit cookie rather than the next cookie in the rotation.
+type Resource struct {
+	client   *client.Client
+	validate *validator.Validate
+	cookies  CookieStore
+}
+
+// New creates a new Resource with the specified client and validator.
+// The cookie store is updated when a cookie is refreshed or ended; it may be nil.
+func New(client *client.Client, validate *validator.Validate, cookies CookieStore) *Resource {
+	return &Resource{
+		client:   client,
+		validate: validate,
+		cookies:  cookies,
+	}
+}
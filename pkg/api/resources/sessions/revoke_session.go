@@ -0,0 +1,59 @@
+package sessions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// RevokeSession ends one of the sessions of the cookie's account.
+// If the revoked session is the cookie's own, the cookie is removed from the cookie store.
+// POST https://apis.roblox.com/token-metadata-service/v1/logout
+func (r *Resource) RevokeSession(ctx context.Context, p RevokeSessionParams) error {
+	if err := r.validate.Struct(p); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	resp, err := r.post(ctx, p.Cookie, types.ApisEndpoint+"/token-metadata-service/v1/logout", p)
+	if err != nil {
+		return err
+	}
+
+	_ = resp.Body.Close()
+
+	if p.IsCurrentSession && r.cookies != nil {
+		r.cookies.RemoveCookie(p.Cookie)
+	}
+
+	return nil
+}
+
+// RevokeSessionParams holds the parameters for revoking a session.
+type RevokeSessionParams struct {
+	Cookie           string `json:"-"     validate:"required"` // Cookie of the account the session belongs to
+	Token            string `json:"token" validate:"required"` // Token of the session to revoke
+	IsCurrentSession bool   `json:"-"`                         // Whether the session is the cookie's own
+}
+
+// RevokeSessionBuilder is a builder for RevokeSessionParams.
+type RevokeSessionBuilder struct {
+	params RevokeSessionParams
+}
+
+// NewRevokeSessionBuilder creates a new RevokeSessionBuilder for a session listed with the cookie.
+func NewRevokeSessionBuilder(cookie string, session *types.Session) *RevokeSessionBuilder {
+	return &RevokeSessionBuilder{
+		params: RevokeSessionParams{
+			Cookie:           cookie,
+			Token:            session.Token,
+			IsCurrentSession: session.IsCurrentSession,
+		},
+	}
+}
+
+// Build returns the RevokeSessionParams.
+func (b *RevokeSessionBuilder) Build() RevokeSessionParams {
+	return b.params
+}
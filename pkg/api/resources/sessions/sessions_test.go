@@ -0,0 +1,262 @@
+package sessions_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/axonet/pkg/client/logger"
+	"github.com/jaxron/axonet/pkg/client/middleware"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/resources/sessions"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sessionServer is a stand-in for the session endpoints. Every cookie is its own session,
+// with a CSRF token that only works for that cookie.
+type sessionServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	cookies map[string]bool
+}
+
+func newSessionServer(cookies ...string) *sessionServer {
+	s := &sessionServer{cookies: make(map[string]bool)}
+	for _, cookie := range cookies {
+		s.cookies[cookie] = true
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /token-metadata-service/v1/sessions", func(w http.ResponseWriter, r *http.Request) {
+		current := s.cookie(r)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		list := make([]types.Session, 0, len(s.cookies))
+		for cookie, active := range s.cookies {
+			if active {
+				list = append(list, types.Session{Token: "token-" + cookie, IsCurrentSession: cookie == current})
+			}
+		}
+
+		_ = json.NewEncoder(w).Encode(types.SessionsResponse{Sessions: list})
+	})
+	mux.HandleFunc("POST /token-metadata-service/v1/logout", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Token string `json:"token"`
+		}
+
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		s.end(strings.TrimPrefix(body.Token, "token-"))
+		_, _ = w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("POST /v2/logoutfromallsessionsandreauthenticate", func(w http.ResponseWriter, r *http.Request) {
+		cookie := s.cookie(r)
+
+		s.mu.Lock()
+		for other := range s.cookies {
+			s.cookies[other] = false
+		}
+
+		s.cookies[cookie+"-refreshed"] = true
+		s.mu.Unlock()
+
+		http.SetCookie(w, &http.Cookie{Name: ".ROBLOSECURITY", Value: cookie + "-refreshed"})
+		_, _ = w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("POST /v2/logout", func(w http.ResponseWriter, r *http.Request) {
+		s.end(s.cookie(r))
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	s.Server = httptest.NewServer(s.authenticate(mux))
+
+	return s
+}
+
+// authenticate rejects inactive cookies and POST requests without the cookie's CSRF token.
+func (s *sessionServer) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookie := s.cookie(r)
+
+		s.mu.Lock()
+		active := s.cookies[cookie]
+		s.mu.Unlock()
+
+		if r.Method == http.MethodPost && r.Header.Get("X-Csrf-Token") != "csrf-"+cookie {
+			w.Header().Set("X-Csrf-Token", "csrf-"+cookie)
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":[{"code":0,"message":"Token Validation Failed"}]}`))
+
+			return
+		}
+
+		if !active {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors":[{"code":0,"message":"Authorization has been denied for this request."}]}`))
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *sessionServer) cookie(r *http.Request) string {
+	cookie, err := r.Cookie(".ROBLOSECURITY")
+	if err != nil {
+		return ""
+	}
+
+	return cookie.Value
+}
+
+func (s *sessionServer) end(cookie string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cookies[cookie] = false
+}
+
+// redirect sends every request, including CSRF token requests, to the stand-in server.
+type redirect struct {
+	target *url.URL
+}
+
+func (m *redirect) Process(ctx context.Context, httpClient *http.Client, req *http.Request, next middleware.NextFunc) (*http.Response, error) {
+	return next(ctx, &http.Client{Transport: m}, req)
+}
+
+func (m *redirect) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = m.target.Scheme
+	req.URL.Host = m.target.Host
+	req.Host = m.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func (m *redirect) SetLogger(logger.Logger) {}
+
+// cookiesOf returns the cookies in the middleware's rotation.
+func cookiesOf(t *testing.T, middleware *auth.Middleware) []string {
+	t.Helper()
+
+	cookies := make([]string, 0, middleware.GetCookieCount())
+	for range middleware.GetCookieCount() {
+		cookie, err := middleware.NextCookie()
+		require.NoError(t, err)
+
+		cookies = append(cookies, cookie)
+	}
+
+	return cookies
+}
+
+func TestSessionChanges(t *testing.T) {
+	newResource := func(server *sessionServer, cookies ...string) (*sessions.Resource, *auth.Middleware) {
+		target, _ := url.Parse(server.URL)
+		authMiddleware := auth.New(cookies)
+
+		c := client.NewClient(
+			client.WithMiddleware(&redirect{target: target}),
+			client.WithMiddleware(authMiddleware),
+		)
+
+		return sessions.New(c, validator.New(validator.WithRequiredStructEnabled()), authMiddleware), authMiddleware
+	}
+
+	t.Run("Sign Out Other Sessions Replaces Cookie", func(t *testing.T) {
+		server := newSessionServer("cookie1", "cookie2")
+		defer server.Close()
+
+		api, authMiddleware := newResource(server, "cookie1", "cookie2")
+
+		cookie, err := api.SignOutOtherSessions(context.Background(), "cookie2")
+		require.NoError(t, err)
+		assert.Equal(t, "cookie2-refreshed", cookie)
+		assert.ElementsMatch(t, []string{"cookie1", "cookie2-refreshed"}, cookiesOf(t, authMiddleware))
+
+		result, err := api.GetSessions(context.Background(), sessions.NewSessionsBuilder(cookie).Build())
+		require.NoError(t, err)
+		require.Len(t, result.Sessions, 1)
+		assert.True(t, result.Sessions[0].IsCurrentSession)
+		assert.Equal(t, "token-cookie2-refreshed", result.Sessions[0].Token)
+	})
+
+	t.Run("Revoke Own Session Removes Cookie", func(t *testing.T) {
+		server := newSessionServer("cookie1", "cookie2", "cookie3")
+		defer server.Close()
+
+		api, authMiddleware := newResource(server, "cookie1", "cookie2", "cookie3")
+
+		result, err := api.GetSessions(context.Background(), sessions.NewSessionsBuilder("cookie1").Build())
+		require.NoError(t, err)
+		require.Len(t, result.Sessions, 3)
+
+		// Revoking another session leaves the cookie in place
+		for _, session := range result.Sessions {
+			if session.Token == "token-cookie3" {
+				require.NoError(t, api.RevokeSession(context.Background(), sessions.NewRevokeSessionBuilder("cookie1", &session).Build()))
+			}
+		}
+
+		assert.Equal(t, 3, authMiddleware.GetCookieCount())
+
+		for _, session := range result.Sessions {
+			if session.IsCurrentSession {
+				require.NoError(t, api.RevokeSession(context.Background(), sessions.NewRevokeSessionBuilder("cookie1", &session).Build()))
+			}
+		}
+
+		assert.ElementsMatch(t, []string{"cookie2", "cookie3"}, cookiesOf(t, authMiddleware))
+	})
+
+	t.Run("Logout Removes Cookie", func(t *testing.T) {
+		server := newSessionServer("cookie1", "cookie2")
+		defer server.Close()
+
+		api, authMiddleware := newResource(server, "cookie1", "cookie2")
+
+		// The CSRF token cached for the first cookie is rejected for the second and retried
+		require.NoError(t, api.Logout(context.Background(), "cookie1"))
+		require.NoError(t, api.Logout(context.Background(), "cookie2"))
+		assert.Zero(t, authMiddleware.GetCookieCount())
+	})
+
+	t.Run("Failed Logout Keeps Cookie", func(t *testing.T) {
+		server := newSessionServer("cookie1")
+		defer server.Close()
+
+		api, authMiddleware := newResource(server, "cookie1", "expired")
+
+		err := api.Logout(context.Background(), "expired")
+
+		var apiErr *errs.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, 2, authMiddleware.GetCookieCount())
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		api := sessions.New(nil, validator.New(validator.WithRequiredStructEnabled()), nil)
+
+		_, err := api.SignOutOtherSessions(context.Background(), "")
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+
+		err = api.RevokeSession(context.Background(), sessions.RevokeSessionParams{Cookie: "cookie"})
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "Token")
+	})
+}
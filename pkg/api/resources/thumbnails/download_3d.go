@@ -0,0 +1,115 @@
+package thumbnails
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ErrInvalidFileName is returned when a file name is not a single path element.
+var ErrInvalidFileName = errors.New("invalid file name")
+
+// FileWriter creates the files that downloaded 3D thumbnail files are written to.
+// It mirrors the read-only io/fs.FS interface for writing.
+type FileWriter interface {
+	Create(name string) (io.WriteCloser, error)
+}
+
+// DirWriter is a FileWriter that creates files in a directory on disk.
+type DirWriter string
+
+// Create creates or truncates the named file in the directory.
+// Names that are not a single path element are rejected, so files cannot escape the directory.
+func (d DirWriter) Create(name string) (io.WriteCloser, error) {
+	if name == "" || name == "." || name == ".." || filepath.Base(name) != name {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidFileName, name)
+	}
+
+	return os.Create(filepath.Join(string(d), name))
+}
+
+// Thumbnail3DFiles lists the names of the files written for a 3D thumbnail.
+// Files are named after their content hash, which is how the OBJ and MTL files reference each other.
+type Thumbnail3DFiles struct {
+	OBJ      string   // Name of the OBJ file
+	MTL      string   // Name of the MTL file
+	Textures []string // Names of the texture files
+}
+
+// CDNURL resolves a Roblox CDN content hash to the URL it is served from.
+// The host is picked by XOR-ing the characters of the hash, starting from 31.
+func CDNURL(hash string) string {
+	i := 31
+	for _, c := range []byte(hash[:min(len(hash), 32)]) {
+		i ^= int(c)
+	}
+
+	return fmt.Sprintf("https://t%d.rbxcdn.com/%s", i%8, hash)
+}
+
+// Download3DFiles downloads the OBJ, MTL and texture files of a 3D thumbnail manifest
+// into the given writer.
+func (r *Resource) Download3DFiles(ctx context.Context, manifest *types.Thumbnail3DManifest, w FileWriter) (*Thumbnail3DFiles, error) {
+	if err := r.validate.Struct(manifest); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	hashes := append([]string{manifest.OBJ, manifest.MTL}, manifest.Textures...)
+	for _, hash := range hashes {
+		if err := r.downloadCDNFile(ctx, hash, w); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Thumbnail3DFiles{
+		OBJ:      manifest.OBJ,
+		MTL:      manifest.MTL,
+		Textures: manifest.Textures,
+	}, nil
+}
+
+// Download3DFilesToDir downloads the files of a 3D thumbnail manifest into a directory,
+// creating it if needed.
+func (r *Resource) Download3DFilesToDir(ctx context.Context, manifest *types.Thumbnail3DManifest, dir string) (*Thumbnail3DFiles, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	return r.Download3DFiles(ctx, manifest, DirWriter(dir))
+}
+
+// downloadCDNFile downloads a single file from the CDN and writes it under its hash.
+func (r *Resource) downloadCDNFile(ctx context.Context, hash string, w FileWriter) error {
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(CDNURL(hash)).
+		Do(ctx)
+	if err != nil {
+		return errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	file, err := w.Create(hash)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", hash, err)
+	}
+
+	if _, err := io.Copy(file, resp.Body); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to write %s: %w", hash, err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", hash, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package thumbnails
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg" // Register the JPEG decoder
+	_ "image/png"  // Register the PNG decoder
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	_ "golang.org/x/image/webp" // Register the WebP decoder
+	"golang.org/x/sync/errgroup"
+)
+
+// Image describes a thumbnail image stored in the downloader's cache.
+type Image struct {
+	URL     string // URL the image was downloaded from
+	Version string // Thumbnail version the image belongs to
+	Path    string // Path of the cached file
+	Format  string // Image format ("png", "jpeg" or "webp")
+	Width   int    // Width in pixels
+	Height  int    // Height in pixels
+	Size    int64  // Size of the file in bytes
+}
+
+// Decode opens the cached file and decodes the full image.
+func (i *Image) Decode() (image.Image, error) {
+	file, err := os.Open(i.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = file.Close() }()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", i.Path, err)
+	}
+
+	return img, nil
+}
+
+// Downloader fetches thumbnail images through a client.Client, so that proxies and retries apply,
+// and stores them in an on-disk cache addressed by the hash of their URL and version.
+type Downloader struct {
+	client      *client.Client
+	cacheDir    string
+	concurrency int
+}
+
+// NewDownloader creates a new Downloader that caches images in cacheDir and
+// downloads at most concurrency images at once in bulk downloads.
+func NewDownloader(client *client.Client, cacheDir string, concurrency int) *Downloader {
+	return &Downloader{
+		client:      client,
+		cacheDir:    cacheDir,
+		concurrency: max(concurrency, 1),
+	}
+}
+
+// Download returns the image of a completed thumbnail, downloading it if it is not cached yet.
+func (d *Downloader) Download(ctx context.Context, data types.ThumbnailData) (*Image, error) {
+	if data.State != types.ThumbnailStateCompleted || data.ImageURL == nil || *data.ImageURL == "" {
+		return nil, fmt.Errorf("%w: state %s", ErrThumbnailUnavailable, data.State)
+	}
+
+	url := *data.ImageURL
+
+	version := ""
+	if data.Version != nil {
+		version = *data.Version
+	}
+
+	path := d.cachePath(url, version)
+
+	// Download the file only on a cache miss
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		if err := d.fetch(ctx, url, path); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read cache: %w", err)
+	}
+
+	return readImage(url, version, path)
+}
+
+// DownloadAll downloads the images of many thumbnails concurrently.
+// Thumbnails sharing a URL and version are only downloaded once.
+// The result is aligned with data; entries that are not completed are left nil.
+func (d *Downloader) DownloadAll(ctx context.Context, data []types.ThumbnailData) ([]*Image, error) {
+	images := make([]*Image, len(data))
+
+	// Group the entries by cache path so each image is only fetched once
+	groups := make(map[string][]int)
+	order := make([]string, 0, len(data))
+
+	for i, entry := range data {
+		if entry.State != types.ThumbnailStateCompleted || entry.ImageURL == nil || *entry.ImageURL == "" {
+			continue
+		}
+
+		version := ""
+		if entry.Version != nil {
+			version = *entry.Version
+		}
+
+		path := d.cachePath(*entry.ImageURL, version)
+		if _, found := groups[path]; !found {
+			order = append(order, path)
+		}
+
+		groups[path] = append(groups[path], i)
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(d.concurrency)
+
+	for _, path := range order {
+		indexes := groups[path]
+
+		g.Go(func() error {
+			img, err := d.Download(ctx, data[indexes[0]])
+			if err != nil {
+				return err
+			}
+
+			// Each goroutine writes to its own indexes, so no locking is needed
+			for _, i := range indexes {
+				images[i] = img
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// cachePath returns the path an image is cached at.
+func (d *Downloader) cachePath(url, version string) string {
+	sum := sha256.Sum256([]byte(url + "\n" + version))
+	key := hex.EncodeToString(sum[:])
+
+	return filepath.Join(d.cacheDir, key[:2], key)
+}
+
+// fetch downloads an image and atomically moves it into the cache once it is known to decode.
+func (d *Downloader) fetch(ctx context.Context, url, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	resp, err := d.client.NewRequest().
+		Method(http.MethodGet).
+		URL(url).
+		Do(ctx)
+	if err != nil {
+		return errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	// Write to a temporary file first so a partial download never appears in the cache
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".download-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := io.Copy(tmp, resp.Body); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to download %s: %w", url, err)
+	}
+
+	// Only cache images, so that an error page does not stay in the cache for good
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to download %s: %w", url, err)
+	}
+
+	if _, _, err := image.DecodeConfig(tmp); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to decode %s: %w", url, err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to download %s: %w", url, err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store %s: %w", url, err)
+	}
+
+	return nil
+}
+
+// readImage decodes the metadata of a cached image.
+func readImage(url, version, path string) (*Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = file.Close() }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	config, format, err := image.DecodeConfig(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", url, err)
+	}
+
+	return &Image{
+		URL:     url,
+		Version: version,
+		Path:    path,
+		Format:  format,
+		Width:   config.Width,
+		Height:  config.Height,
+		Size:    info.Size(),
+	}, nil
+}
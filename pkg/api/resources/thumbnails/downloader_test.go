@@ -0,0 +1,148 @@
+package thumbnails_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/resources/thumbnails"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloader(t *testing.T) {
+	// Serve a PNG and a JPEG image and count how often each is requested
+	var pngBuf, jpegBuf bytes.Buffer
+	require.NoError(t, png.Encode(&pngBuf, image.NewRGBA(image.Rect(0, 0, 150, 150))))
+	require.NoError(t, jpeg.Encode(&jpegBuf, image.NewRGBA(image.Rect(0, 0, 420, 420)), nil))
+
+	var hits atomic.Int64
+
+	// The flaky image is served as an HTML error page until fixed is set
+	var fixed atomic.Bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+
+		switch r.URL.Path {
+		case "/image.png":
+			_, _ = w.Write(pngBuf.Bytes())
+		case "/image.jpeg":
+			_, _ = w.Write(jpegBuf.Bytes())
+		case "/flaky.png":
+			if fixed.Load() {
+				_, _ = w.Write(pngBuf.Bytes())
+			} else {
+				_, _ = w.Write([]byte("<html>Service Unavailable</html>"))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	thumbnail := func(path, version string) types.ThumbnailData {
+		url := server.URL + path
+		return types.ThumbnailData{
+			RequestID: path,
+			State:     types.ThumbnailStateCompleted,
+			ImageURL:  &url,
+			Version:   &version,
+		}
+	}
+
+	t.Run("Download And Cache", func(t *testing.T) {
+		hits.Store(0)
+		downloader := thumbnails.NewDownloader(client.NewClient(), t.TempDir(), 4)
+
+		img, err := downloader.Download(context.Background(), thumbnail("/image.png", "TN1"))
+		require.NoError(t, err)
+		assert.Equal(t, "png", img.Format)
+		assert.Equal(t, 150, img.Width)
+		assert.Equal(t, 150, img.Height)
+		assert.Equal(t, int64(pngBuf.Len()), img.Size)
+		assert.FileExists(t, img.Path)
+
+		cached, err := downloader.Download(context.Background(), thumbnail("/image.png", "TN1"))
+		require.NoError(t, err)
+		assert.Equal(t, img.Path, cached.Path)
+		assert.Equal(t, int64(1), hits.Load())
+
+		decoded, err := cached.Decode()
+		require.NoError(t, err)
+		assert.Equal(t, 150, decoded.Bounds().Dx())
+	})
+
+	t.Run("New Version Is Downloaded Again", func(t *testing.T) {
+		hits.Store(0)
+		downloader := thumbnails.NewDownloader(client.NewClient(), t.TempDir(), 4)
+
+		first, err := downloader.Download(context.Background(), thumbnail("/image.png", "TN1"))
+		require.NoError(t, err)
+
+		second, err := downloader.Download(context.Background(), thumbnail("/image.png", "TN2"))
+		require.NoError(t, err)
+		assert.NotEqual(t, first.Path, second.Path)
+		assert.Equal(t, int64(2), hits.Load())
+	})
+
+	t.Run("Bulk Download With Dedup", func(t *testing.T) {
+		hits.Store(0)
+		downloader := thumbnails.NewDownloader(client.NewClient(), t.TempDir(), 2)
+
+		pending := types.ThumbnailData{RequestID: "pending", State: types.ThumbnailStatePending}
+		data := []types.ThumbnailData{
+			thumbnail("/image.png", "TN1"),
+			thumbnail("/image.jpeg", "TN1"),
+			thumbnail("/image.png", "TN1"),
+			pending,
+		}
+
+		images, err := downloader.DownloadAll(context.Background(), data)
+		require.NoError(t, err)
+		require.Len(t, images, 4)
+		assert.Equal(t, "png", images[0].Format)
+		assert.Equal(t, "jpeg", images[1].Format)
+		assert.Equal(t, 420, images[1].Width)
+		assert.Same(t, images[0], images[2])
+		assert.Nil(t, images[3])
+		assert.Equal(t, int64(2), hits.Load())
+	})
+
+	t.Run("Pending Thumbnail", func(t *testing.T) {
+		downloader := thumbnails.NewDownloader(client.NewClient(), t.TempDir(), 1)
+
+		_, err := downloader.Download(context.Background(), types.ThumbnailData{State: types.ThumbnailStatePending})
+		require.ErrorIs(t, err, thumbnails.ErrThumbnailUnavailable)
+	})
+
+	t.Run("Missing Image", func(t *testing.T) {
+		downloader := thumbnails.NewDownloader(client.NewClient(), t.TempDir(), 1)
+
+		_, err := downloader.Download(context.Background(), thumbnail("/missing", "TN1"))
+		require.Error(t, err)
+	})
+
+	t.Run("Undecodable Image Is Not Cached", func(t *testing.T) {
+		hits.Store(0)
+		downloader := thumbnails.NewDownloader(client.NewClient(), t.TempDir(), 1)
+
+		_, err := downloader.Download(context.Background(), thumbnail("/flaky.png", "TN1"))
+		require.ErrorIs(t, err, image.ErrFormat)
+
+		fixed.Store(true)
+
+		img, err := downloader.Download(context.Background(), thumbnail("/flaky.png", "TN1"))
+		require.NoError(t, err)
+		assert.Equal(t, "png", img.Format)
+		assert.Equal(t, int64(2), hits.Load())
+	})
+}
@@ -0,0 +1,205 @@
+package thumbnails
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetGameThumbnails fetches the thumbnails of games by universe ID and re-polls
+// games with pending thumbnails until they resolve or MaxWait passes.
+// GET https://thumbnails.roblox.com/v1/games/multiget/thumbnails
+func (r *Resource) GetGameThumbnails(ctx context.Context, p GameThumbnailsParams) (*types.GameThumbnailsResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	if err := validateSize(types.GameThumbnailType, p.Size); err != nil {
+		return nil, err
+	}
+
+	result, err := r.getGameThumbnails(ctx, p.UniverseIDs, p)
+	if err != nil {
+		return nil, err
+	}
+
+	isPending := func(game types.GameThumbnails) bool {
+		return slices.ContainsFunc(game.Thumbnails, func(thumbnail types.GameThumbnail) bool {
+			return thumbnail.State == types.ThumbnailStatePending
+		})
+	}
+
+	pending := func() bool {
+		return slices.ContainsFunc(result.Data, isPending)
+	}
+
+	refresh := func(ctx context.Context) error {
+		// Re-request only the games that still have pending thumbnails
+		index := make(map[int64]int)
+		retry := make([]int64, 0)
+
+		for i, game := range result.Data {
+			if isPending(game) {
+				index[game.UniverseID] = i
+				retry = append(retry, game.UniverseID)
+			}
+		}
+
+		retried, err := r.getGameThumbnails(ctx, retry, p)
+		if err != nil {
+			return err
+		}
+
+		for _, game := range retried.Data {
+			if i, found := index[game.UniverseID]; found {
+				result.Data[i] = game
+			}
+		}
+
+		return nil
+	}
+
+	if err := pollPending(ctx, p.MaxWait, pending, refresh); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// getGameThumbnails fetches the thumbnails of the given games once.
+func (r *Resource) getGameThumbnails(ctx context.Context, universeIDs []int64, p GameThumbnailsParams) (*types.GameThumbnailsResponse, error) {
+	var result types.GameThumbnailsResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(types.ThumbnailsEndpoint+"/v1/games/multiget/thumbnails").
+		Query("universeIds", joinIDs(universeIDs)).
+		Query("countPerUniverse", strconv.FormatInt(p.CountPerUniverse, 10)).
+		Query("defaults", strconv.FormatBool(p.Defaults)).
+		Query("size", string(p.Size)).
+		Query("format", formatQuery(p.Format)).
+		Query("isCircular", strconv.FormatBool(p.IsCircular)).
+		Result(&result).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&result); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &result, nil
+}
+
+// GameThumbnailsParams holds the parameters for getting game thumbnails.
+type GameThumbnailsParams struct {
+	UniverseIDs      []int64               `json:"universeIds"      validate:"required,min=1,max=100,dive,gt=0"`
+	CountPerUniverse int64                 `json:"countPerUniverse" validate:"min=1,max=10"`
+	Defaults         bool                  `json:"defaults"`
+	Size             types.ThumbnailSize   `json:"size"             validate:"required"`
+	Format           types.ThumbnailFormat `json:"format"           validate:"omitempty,oneof=png jpeg webp"`
+	IsCircular       bool                  `json:"isCircular"`
+	MaxWait          time.Duration         `json:"maxWait"          validate:"min=0"`
+}
+
+// GameThumbnailsBuilder is a builder for GameThumbnailsParams.
+type GameThumbnailsBuilder struct {
+	params GameThumbnailsParams
+}
+
+// NewGameThumbnailsBuilder creates a new GameThumbnailsBuilder with default values.
+// Pending thumbnails are re-polled for up to 30 seconds by default.
+func NewGameThumbnailsBuilder(universeIDs ...int64) *GameThumbnailsBuilder {
+	return &GameThumbnailsBuilder{
+		params: GameThumbnailsParams{
+			UniverseIDs:      universeIDs,
+			CountPerUniverse: 1,
+			Defaults:         true,
+			Size:             types.Size768x432,
+			Format:           types.PNG,
+			IsCircular:       false,
+			MaxWait:          30 * time.Second,
+		},
+	}
+}
+
+// WithUniverseIDs adds multiple universe IDs to the list.
+func (b *GameThumbnailsBuilder) WithUniverseIDs(universeIDs ...int64) *GameThumbnailsBuilder {
+	b.params.UniverseIDs = append(b.params.UniverseIDs, universeIDs...)
+	return b
+}
+
+// WithCountPerUniverse sets how many thumbnails are returned per game.
+func (b *GameThumbnailsBuilder) WithCountPerUniverse(count int64) *GameThumbnailsBuilder {
+	b.params.CountPerUniverse = count
+	return b
+}
+
+// WithDefaults sets whether a default thumbnail is returned for games without any.
+func (b *GameThumbnailsBuilder) WithDefaults(defaults bool) *GameThumbnailsBuilder {
+	b.params.Defaults = defaults
+	return b
+}
+
+// WithSize sets the thumbnail size.
+func (b *GameThumbnailsBuilder) WithSize(size types.ThumbnailSize) *GameThumbnailsBuilder {
+	b.params.Size = size
+	return b
+}
+
+// WithFormat sets the image format.
+func (b *GameThumbnailsBuilder) WithFormat(format types.ThumbnailFormat) *GameThumbnailsBuilder {
+	b.params.Format = format
+	return b
+}
+
+// WithCircular sets whether the thumbnails are cropped to a circle.
+func (b *GameThumbnailsBuilder) WithCircular(isCircular bool) *GameThumbnailsBuilder {
+	b.params.IsCircular = isCircular
+	return b
+}
+
+// WithMaxWait sets how long pending thumbnails are re-polled for. Zero disables re-polling.
+func (b *GameThumbnailsBuilder) WithMaxWait(maxWait time.Duration) *GameThumbnailsBuilder {
+	b.params.MaxWait = maxWait
+	return b
+}
+
+// Build returns the GameThumbnailsParams.
+func (b *GameThumbnailsBuilder) Build() GameThumbnailsParams {
+	return b.params
+}
+
+// formatQuery converts a thumbnail format to the casing used by the GET endpoints.
+func formatQuery(format types.ThumbnailFormat) string {
+	switch format {
+	case types.JPEG:
+		return "Jpeg"
+	case types.WEBP:
+		return "Webp"
+	case types.PNG:
+		return "Png"
+	default:
+		return ""
+	}
+}
+
+// joinIDs converts IDs to a comma-separated list.
+func joinIDs(ids []int64) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.FormatInt(id, 10)
+	}
+
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,60 @@
+package thumbnails_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/resources/thumbnails"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGameThumbnails(t *testing.T) {
+	// Create a new test resource
+	api := thumbnails.New(utils.NewTestEnv())
+
+	t.Run("Fetch Game Thumbnails", func(t *testing.T) {
+		builder := thumbnails.NewGameThumbnailsBuilder(utils.SampleUniverseID).WithCountPerUniverse(2)
+		result, err := api.GetGameThumbnails(context.Background(), builder.Build())
+		require.NoError(t, err)
+		require.Len(t, result.Data, 1)
+		assert.Equal(t, utils.SampleUniverseID, result.Data[0].UniverseID)
+	})
+
+	t.Run("Fetch Game Icons", func(t *testing.T) {
+		builder := thumbnails.NewThumbnailBuilder(types.Size512x512, utils.SampleUniverseID)
+		result, err := api.GetGameIcons(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.Len(t, result.Data, 1)
+	})
+
+	t.Run("Unsupported Size", func(t *testing.T) {
+		builder := thumbnails.NewGameThumbnailsBuilder(utils.SampleUniverseID).WithSize(types.Size150x150)
+		_, err := api.GetGameThumbnails(context.Background(), builder.Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := thumbnails.NewGameThumbnailsBuilder(utils.SampleUniverseID).
+			WithUniverseIDs(utils.SampleUniverseID + 1).
+			WithCountPerUniverse(5).
+			WithDefaults(false).
+			WithSize(types.Size384x216).
+			WithFormat(types.JPEG).
+			WithCircular(true).
+			WithMaxWait(0)
+
+		params := builder.Build()
+		assert.Len(t, params.UniverseIDs, 2)
+		assert.Equal(t, int64(5), params.CountPerUniverse)
+		assert.False(t, params.Defaults)
+		assert.Equal(t, types.Size384x216, params.Size)
+		assert.Equal(t, types.JPEG, params.Format)
+		assert.True(t, params.IsCircular)
+		assert.Equal(t, time.Duration(0), params.MaxWait)
+	})
+}
@@ -0,0 +1,131 @@
+package thumbnails
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jaxron/roapi.go/internal/backoff"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// ErrThumbnailUnavailable is returned when a thumbnail ends in a state that will not complete.
+var ErrThumbnailUnavailable = errors.New("thumbnail is unavailable")
+
+// GetAvatar3D fetches the current state of a user's 3D avatar thumbnail.
+// GET https://thumbnails.roblox.com/v1/users/avatar-3d
+func (r *Resource) GetAvatar3D(ctx context.Context, userID int64) (*types.Thumbnail3DResponse, error) {
+	if err := r.validate.Var(userID, "required,gt=0"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	return r.getThumbnail3D(ctx, types.ThumbnailsEndpoint+"/v1/users/avatar-3d", "userId", userID)
+}
+
+// GetAsset3D fetches the current state of an asset's 3D thumbnail.
+// GET https://thumbnails.roblox.com/v1/assets-thumbnail-3d
+func (r *Resource) GetAsset3D(ctx context.Context, assetID int64) (*types.Thumbnail3DResponse, error) {
+	if err := r.validate.Var(assetID, "required,gt=0"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	return r.getThumbnail3D(ctx, types.ThumbnailsEndpoint+"/v1/assets-thumbnail-3d", "assetId", assetID)
+}
+
+// GetAvatar3DManifest waits for a user's 3D avatar thumbnail to complete and fetches its manifest.
+// The wait is bounded by the context.
+func (r *Resource) GetAvatar3DManifest(ctx context.Context, userID int64) (*types.Thumbnail3DManifest, error) {
+	return r.waitForManifest(ctx, func(ctx context.Context) (*types.Thumbnail3DResponse, error) {
+		return r.GetAvatar3D(ctx, userID)
+	})
+}
+
+// GetAsset3DManifest waits for an asset's 3D thumbnail to complete and fetches its manifest.
+// The wait is bounded by the context.
+func (r *Resource) GetAsset3DManifest(ctx context.Context, assetID int64) (*types.Thumbnail3DManifest, error) {
+	return r.waitForManifest(ctx, func(ctx context.Context) (*types.Thumbnail3DResponse, error) {
+		return r.GetAsset3D(ctx, assetID)
+	})
+}
+
+// getThumbnail3D fetches the state of a 3D thumbnail from the given endpoint.
+func (r *Resource) getThumbnail3D(ctx context.Context, url, idParam string, id int64) (*types.Thumbnail3DResponse, error) {
+	var result types.Thumbnail3DResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(url).
+		Query(idParam, strconv.FormatInt(id, 10)).
+		Result(&result).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&result); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &result, nil
+}
+
+// waitForManifest polls a 3D thumbnail with an exponentially increasing delay until it
+// completes and then fetches its manifest. The wait is bounded by the context.
+func (r *Resource) waitForManifest(ctx context.Context, fetch func(ctx context.Context) (*types.Thumbnail3DResponse, error)) (*types.Thumbnail3DManifest, error) {
+	delays := backoff.New(pollInitialDelay, pollMaxDelay, 0)
+
+	for {
+		thumbnail, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		switch thumbnail.State {
+		case types.ThumbnailStateCompleted:
+			if thumbnail.ImageURL == nil {
+				return nil, fmt.Errorf("%w: completed 3D thumbnail has no manifest URL", errs.ErrInvalidResponse)
+			}
+
+			return r.getManifest(ctx, *thumbnail.ImageURL)
+		case types.ThumbnailStatePending:
+		default:
+			return nil, fmt.Errorf("%w: state %s", ErrThumbnailUnavailable, thumbnail.State)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delays.Delay()):
+		}
+
+		delays.Increase()
+	}
+}
+
+// getManifest fetches the JSON manifest of a completed 3D thumbnail.
+func (r *Resource) getManifest(ctx context.Context, url string) (*types.Thumbnail3DManifest, error) {
+	var manifest types.Thumbnail3DManifest
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(url).
+		Result(&manifest).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&manifest); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &manifest, nil
+}
@@ -0,0 +1,174 @@
+package thumbnails_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/resources/thumbnails"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetThumbnail3D(t *testing.T) {
+	// Create a new test resource
+	api := thumbnails.New(utils.NewTestEnv())
+
+	t.Run("Fetch Avatar 3D State", func(t *testing.T) {
+		result, err := api.GetAvatar3D(context.Background(), utils.SampleUserID1)
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.State)
+	})
+
+	t.Run("Fetch Avatar 3D Manifest", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		manifest, err := api.GetAvatar3DManifest(ctx, utils.SampleUserID1)
+		require.NoError(t, err)
+		assert.NotEmpty(t, manifest.OBJ)
+		assert.NotEmpty(t, manifest.MTL)
+	})
+
+	t.Run("Fetch Asset 3D Manifest", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		manifest, err := api.GetAsset3DManifest(ctx, utils.SampleLimitedAssetID)
+		require.NoError(t, err)
+		assert.NotEmpty(t, manifest.OBJ)
+	})
+
+	t.Run("Invalid User ID", func(t *testing.T) {
+		_, err := api.GetAvatar3D(context.Background(), utils.InvalidUserID)
+		require.Error(t, err)
+	})
+
+	t.Run("Invalid Asset ID", func(t *testing.T) {
+		_, err := api.GetAsset3DManifest(context.Background(), utils.InvalidAssetID)
+		require.Error(t, err)
+	})
+
+	t.Run("Download Files", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		manifest, err := api.GetAvatar3DManifest(ctx, utils.SampleUserID1)
+		require.NoError(t, err)
+
+		dir := t.TempDir()
+		files, err := api.Download3DFilesToDir(ctx, manifest, dir)
+		require.NoError(t, err)
+		assert.FileExists(t, dir+"/"+files.OBJ)
+		assert.FileExists(t, dir+"/"+files.MTL)
+
+		for _, texture := range files.Textures {
+			assert.FileExists(t, dir+"/"+texture)
+		}
+	})
+
+	t.Run("Download Invalid Manifest", func(t *testing.T) {
+		_, err := api.Download3DFiles(context.Background(), &types.Thumbnail3DManifest{}, thumbnails.DirWriter(t.TempDir()))
+		require.Error(t, err)
+	})
+}
+
+func TestCDNURL(t *testing.T) {
+	t.Run("Resolve Host From Hash", func(t *testing.T) {
+		// 31 ^ '0' = 15, and 15 % 8 = 7
+		assert.Equal(t, "https://t7.rbxcdn.com/0", thumbnails.CDNURL("0"))
+
+		// 31 ^ 'a' ^ 'b' = 28, and 28 % 8 = 4
+		assert.Equal(t, "https://t4.rbxcdn.com/ab", thumbnails.CDNURL("ab"))
+	})
+
+	t.Run("Only First 32 Characters Count", func(t *testing.T) {
+		hash := "0123456789abcdef0123456789abcdef"
+		assert.Equal(t, thumbnails.CDNURL(hash)[:21], thumbnails.CDNURL(hash + "ff")[:21])
+	})
+}
+
+func TestDownload3DFilesPathSafety(t *testing.T) {
+	api := thumbnails.New(client.NewClient(), validator.New(validator.WithRequiredStructEnabled()))
+
+	t.Run("Reject Non-Hex Hashes", func(t *testing.T) {
+		for _, hash := range []string{"../x", "a/b", "..", "abc/../../etc"} {
+			manifest := &types.Thumbnail3DManifest{OBJ: hash, MTL: "ab12", Textures: []string{"cd34"}}
+
+			_, err := api.Download3DFiles(context.Background(), manifest, thumbnails.DirWriter(t.TempDir()))
+			require.ErrorIs(t, err, errs.ErrInvalidRequest, hash)
+		}
+
+		manifest := &types.Thumbnail3DManifest{OBJ: "ab12", MTL: "cd34", Textures: []string{"../x"}}
+
+		_, err := api.Download3DFiles(context.Background(), manifest, thumbnails.DirWriter(t.TempDir()))
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+	})
+
+	t.Run("Dir Writer Rejects Paths", func(t *testing.T) {
+		parent := t.TempDir()
+		dir := thumbnails.DirWriter(filepath.Join(parent, "files"))
+		require.NoError(t, os.MkdirAll(string(dir), 0o755))
+
+		for _, name := range []string{"", ".", "..", "../x", "a/b", "/etc/passwd"} {
+			_, err := dir.Create(name)
+			require.ErrorIs(t, err, thumbnails.ErrInvalidFileName, name)
+		}
+
+		assert.NoFileExists(t, filepath.Join(parent, "x"))
+
+		file, err := dir.Create("ab12")
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+		assert.FileExists(t, filepath.Join(string(dir), "ab12"))
+	})
+}
+
+func TestWaitForManifest(t *testing.T) {
+	var polls atomic.Int32
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("GET /v1/users/avatar-3d", func(w http.ResponseWriter, _ *http.Request) {
+		if polls.Add(1) <= 1 {
+			utils.WriteJSON(w, map[string]any{"targetId": 1, "state": types.ThumbnailStatePending})
+			return
+		}
+
+		utils.WriteJSON(w, map[string]any{"targetId": 1, "state": types.ThumbnailStateCompleted, "imageUrl": server.URL + "/manifest"})
+	})
+	mux.HandleFunc("GET /manifest", func(w http.ResponseWriter, _ *http.Request) {
+		utils.WriteJSON(w, map[string]any{"mtl": "ab12", "obj": "cd34", "textures": []string{"ef56"}})
+	})
+
+	api := thumbnails.New(utils.NewLocalTestEnv(server.URL))
+
+	t.Run("Poll Until Completed", func(t *testing.T) {
+		manifest, err := api.GetAvatar3DManifest(context.Background(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, "cd34", manifest.OBJ)
+		assert.Equal(t, int32(2), polls.Load())
+	})
+
+	t.Run("Bounded By Context", func(t *testing.T) {
+		polls.Store(-100)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		_, err := api.GetAvatar3DManifest(ctx, 1)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
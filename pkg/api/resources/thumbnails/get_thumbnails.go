@@ -0,0 +1,267 @@
+package thumbnails
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// supportedSizes lists the sizes each kind of thumbnail can be requested in.
+var supportedSizes = map[types.ThumbnailType][]types.ThumbnailSize{
+	types.AvatarType: {
+		types.Size30x30, types.Size48x48, types.Size60x60, types.Size75x75, types.Size100x100,
+		types.Size110x110, types.Size140x140, types.Size150x150, types.Size150x200, types.Size180x180,
+		types.Size250x250, types.Size352x352, types.Size420x420, types.Size720x720,
+	},
+	types.AvatarHeadShotType: {
+		types.Size48x48, types.Size50x50, types.Size60x60, types.Size75x75, types.Size100x100,
+		types.Size110x110, types.Size150x150, types.Size180x180, types.Size352x352, types.Size420x420,
+		types.Size720x720,
+	},
+	types.AvatarBustType: {
+		types.Size48x48, types.Size50x50, types.Size60x60, types.Size75x75, types.Size100x100,
+		types.Size150x150, types.Size180x180, types.Size352x352, types.Size420x420,
+	},
+	types.GroupIconType: {
+		types.Size150x150, types.Size420x420,
+	},
+	types.GameIconType: {
+		types.Size50x50, types.Size128x128, types.Size150x150, types.Size256x256, types.Size420x420,
+		types.Size512x512,
+	},
+	types.GameThumbnailType: {
+		types.Size256x144, types.Size384x216, types.Size480x270, types.Size576x324, types.Size768x432,
+	},
+	types.AssetThumbnailType: {
+		types.Size30x30, types.Size42x42, types.Size50x50, types.Size60x62, types.Size75x75,
+		types.Size110x110, types.Size140x140, types.Size150x150, types.Size160x100, types.Size160x600,
+		types.Size250x250, types.Size256x144, types.Size300x250, types.Size304x166, types.Size384x216,
+		types.Size396x216, types.Size420x420, types.Size480x270, types.Size512x512, types.Size576x324,
+		types.Size700x700, types.Size728x90, types.Size768x432, types.Size1200x80,
+	},
+	types.BadgeIconType: {
+		types.Size150x150,
+	},
+	types.BundleThumbnailType: {
+		types.Size150x150, types.Size420x420,
+	},
+	types.GamePassType: {
+		types.Size150x150,
+	},
+	types.DeveloperProductType: {
+		types.Size150x150, types.Size420x420,
+	},
+}
+
+// GetAvatarThumbnails fetches full-body avatar thumbnails for users.
+func (r *Resource) GetAvatarThumbnails(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error) {
+	return r.getThumbnails(ctx, types.AvatarType, p)
+}
+
+// GetAvatarHeadshots fetches avatar headshot thumbnails for users.
+func (r *Resource) GetAvatarHeadshots(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error) {
+	return r.getThumbnails(ctx, types.AvatarHeadShotType, p)
+}
+
+// GetAvatarBusts fetches avatar bust thumbnails for users.
+func (r *Resource) GetAvatarBusts(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error) {
+	return r.getThumbnails(ctx, types.AvatarBustType, p)
+}
+
+// GetGroupIcons fetches icons for groups.
+func (r *Resource) GetGroupIcons(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error) {
+	return r.getThumbnails(ctx, types.GroupIconType, p)
+}
+
+// GetGameIcons fetches icons for games by universe ID.
+func (r *Resource) GetGameIcons(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error) {
+	return r.getThumbnails(ctx, types.GameIconType, p)
+}
+
+// GetAssetThumbnails fetches thumbnails for assets.
+func (r *Resource) GetAssetThumbnails(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error) {
+	return r.getThumbnails(ctx, types.AssetThumbnailType, p)
+}
+
+// GetBadgeIcons fetches icons for badges.
+func (r *Resource) GetBadgeIcons(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error) {
+	return r.getThumbnails(ctx, types.BadgeIconType, p)
+}
+
+// GetBundleThumbnails fetches thumbnails for bundles.
+func (r *Resource) GetBundleThumbnails(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error) {
+	return r.getThumbnails(ctx, types.BundleThumbnailType, p)
+}
+
+// GetGamePassIcons fetches icons for game passes.
+func (r *Resource) GetGamePassIcons(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error) {
+	return r.getThumbnails(ctx, types.GamePassType, p)
+}
+
+// GetDeveloperProductIcons fetches icons for developer products.
+func (r *Resource) GetDeveloperProductIcons(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error) {
+	return r.getThumbnails(ctx, types.DeveloperProductType, p)
+}
+
+// getThumbnails validates the parameters for the given kind, fetches the thumbnails through
+// the batch endpoint and re-polls pending entries until they resolve or MaxWait passes.
+// Entries that are still pending when MaxWait passes are returned as pending.
+func (r *Resource) getThumbnails(ctx context.Context, thumbnailType types.ThumbnailType, p ThumbnailParams) (*types.BatchThumbnailsResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	if err := validateSize(thumbnailType, p.Size); err != nil {
+		return nil, err
+	}
+
+	// Build one request per unique target
+	requests := make([]types.ThumbnailRequest, 0, len(p.TargetIDs))
+	seen := make(map[int64]struct{}, len(p.TargetIDs))
+
+	for _, id := range p.TargetIDs {
+		if _, found := seen[id]; found {
+			continue
+		}
+
+		seen[id] = struct{}{}
+
+		requests = append(requests, types.ThumbnailRequest{
+			Type:       thumbnailType,
+			Size:       p.Size,
+			RequestID:  fmt.Sprintf("%d:%s:%s", id, thumbnailType, p.Size),
+			TargetID:   id,
+			Token:      "",
+			Alias:      "",
+			Format:     p.Format,
+			IsCircular: p.IsCircular,
+		})
+	}
+
+	result, err := r.GetBatchThumbnails(ctx, BatchThumbnailsParams{Requests: requests})
+	if err != nil {
+		return nil, err
+	}
+
+	pending := func() bool {
+		return slices.ContainsFunc(result.Data, func(data types.ThumbnailData) bool {
+			return data.State == types.ThumbnailStatePending
+		})
+	}
+
+	refresh := func(ctx context.Context) error {
+		// Re-request only the entries that are still pending
+		index := make(map[string]int)
+		retry := make([]types.ThumbnailRequest, 0)
+
+		for i, data := range result.Data {
+			if data.State != types.ThumbnailStatePending {
+				continue
+			}
+
+			index[data.RequestID] = i
+
+			if j := slices.IndexFunc(requests, func(req types.ThumbnailRequest) bool { return req.RequestID == data.RequestID }); j >= 0 {
+				retry = append(retry, requests[j])
+			}
+		}
+
+		retried, err := r.GetBatchThumbnails(ctx, BatchThumbnailsParams{Requests: retry})
+		if err != nil {
+			return err
+		}
+
+		for _, data := range retried.Data {
+			if i, found := index[data.RequestID]; found {
+				result.Data[i] = data
+			}
+		}
+
+		return nil
+	}
+
+	if err := pollPending(ctx, p.MaxWait, pending, refresh); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// validateSize checks that the size is supported for the given kind of thumbnail.
+func validateSize(thumbnailType types.ThumbnailType, size types.ThumbnailSize) error {
+	if !slices.Contains(supportedSizes[thumbnailType], size) {
+		return fmt.Errorf("%w: size %s is not supported for %s thumbnails", errs.ErrInvalidRequest, size, thumbnailType)
+	}
+
+	return nil
+}
+
+// ThumbnailParams holds the parameters shared by the per-kind thumbnail methods.
+type ThumbnailParams struct {
+	TargetIDs  []int64               `json:"targetIds"  validate:"required,min=1,max=100,dive,gt=0"`
+	Size       types.ThumbnailSize   `json:"size"       validate:"required"`
+	Format     types.ThumbnailFormat `json:"format"     validate:"omitempty,oneof=png jpeg webp"`
+	IsCircular bool                  `json:"isCircular"`
+	MaxWait    time.Duration         `json:"maxWait"    validate:"min=0"`
+}
+
+// ThumbnailBuilder is a builder for ThumbnailParams.
+type ThumbnailBuilder struct {
+	params ThumbnailParams
+}
+
+// NewThumbnailBuilder creates a new ThumbnailBuilder with default values.
+// Pending thumbnails are re-polled for up to 30 seconds by default.
+func NewThumbnailBuilder(size types.ThumbnailSize, targetIDs ...int64) *ThumbnailBuilder {
+	return &ThumbnailBuilder{
+		params: ThumbnailParams{
+			TargetIDs:  targetIDs,
+			Size:       size,
+			Format:     types.PNG,
+			IsCircular: false,
+			MaxWait:    30 * time.Second,
+		},
+	}
+}
+
+// WithTargetIDs adds multiple target IDs to the list.
+func (b *ThumbnailBuilder) WithTargetIDs(targetIDs ...int64) *ThumbnailBuilder {
+	b.params.TargetIDs = append(b.params.TargetIDs, targetIDs...)
+	return b
+}
+
+// RemoveTargetIDs removes multiple target IDs from the list.
+func (b *ThumbnailBuilder) RemoveTargetIDs(targetIDs ...int64) *ThumbnailBuilder {
+	b.params.TargetIDs = slices.DeleteFunc(b.params.TargetIDs, func(id int64) bool {
+		return slices.Contains(targetIDs, id)
+	})
+
+	return b
+}
+
+// WithFormat sets the image format.
+func (b *ThumbnailBuilder) WithFormat(format types.ThumbnailFormat) *ThumbnailBuilder {
+	b.params.Format = format
+	return b
+}
+
+// WithCircular sets whether the thumbnails are cropped to a circle.
+func (b *ThumbnailBuilder) WithCircular(isCircular bool) *ThumbnailBuilder {
+	b.params.IsCircular = isCircular
+	return b
+}
+
+// WithMaxWait sets how long pending thumbnails are re-polled for. Zero disables re-polling.
+func (b *ThumbnailBuilder) WithMaxWait(maxWait time.Duration) *ThumbnailBuilder {
+	b.params.MaxWait = maxWait
+	return b
+}
+
+// Build returns the ThumbnailParams.
+func (b *ThumbnailBuilder) Build() ThumbnailParams {
+	return b.params
+}
@@ -0,0 +1,82 @@
+package thumbnails_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/resources/thumbnails"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetThumbnails(t *testing.T) {
+	// Create a new test resource
+	api := thumbnails.New(utils.NewTestEnv())
+
+	t.Run("Fetch Avatar Headshots", func(t *testing.T) {
+		builder := thumbnails.NewThumbnailBuilder(types.Size150x150, utils.SampleUserID1, utils.SampleUserID2)
+		result, err := api.GetAvatarHeadshots(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.Len(t, result.Data, 2)
+
+		for _, data := range result.Data {
+			assert.NotEqual(t, types.ThumbnailStatePending, data.State)
+		}
+	})
+
+	t.Run("Fetch Group Icons", func(t *testing.T) {
+		builder := thumbnails.NewThumbnailBuilder(types.Size420x420, utils.SampleGroupID)
+		result, err := api.GetGroupIcons(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.Len(t, result.Data, 1)
+		assert.Equal(t, utils.SampleGroupID, result.Data[0].TargetID)
+	})
+
+	t.Run("Fetch Asset Thumbnails", func(t *testing.T) {
+		builder := thumbnails.NewThumbnailBuilder(types.Size420x420, utils.SampleAssetID, utils.SampleAssetID2).
+			WithFormat(types.WEBP)
+		result, err := api.GetAssetThumbnails(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.Len(t, result.Data, 2)
+	})
+
+	t.Run("Duplicate Targets Are Merged", func(t *testing.T) {
+		builder := thumbnails.NewThumbnailBuilder(types.Size150x150, utils.SampleBundleID, utils.SampleBundleID)
+		result, err := api.GetBundleThumbnails(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.Len(t, result.Data, 1)
+	})
+
+	t.Run("Unsupported Size For Kind", func(t *testing.T) {
+		builder := thumbnails.NewThumbnailBuilder(types.Size720x720, utils.SampleGroupID)
+		_, err := api.GetGroupIcons(context.Background(), builder.Build())
+		require.ErrorIs(t, err, errs.ErrInvalidRequest)
+		assert.Contains(t, err.Error(), "720x720")
+	})
+
+	t.Run("Empty Targets", func(t *testing.T) {
+		builder := thumbnails.NewThumbnailBuilder(types.Size150x150)
+		_, err := api.GetBadgeIcons(context.Background(), builder.Build())
+		require.Error(t, err)
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := thumbnails.NewThumbnailBuilder(types.Size150x150, utils.SampleUserID1).
+			WithTargetIDs(utils.SampleUserID2, utils.SampleUserID3).
+			RemoveTargetIDs(utils.SampleUserID2).
+			WithFormat(types.JPEG).
+			WithCircular(true).
+			WithMaxWait(time.Minute)
+
+		params := builder.Build()
+		assert.Equal(t, []int64{utils.SampleUserID1, utils.SampleUserID3}, params.TargetIDs)
+		assert.Equal(t, types.Size150x150, params.Size)
+		assert.Equal(t, types.JPEG, params.Format)
+		assert.True(t, params.IsCircular)
+		assert.Equal(t, time.Minute, params.MaxWait)
+	})
+}
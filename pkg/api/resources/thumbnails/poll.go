@@ -0,0 +1,45 @@
+package thumbnails
+
+import (
+	"context"
+	"time"
+
+	"github.com/jaxron/roapi.go/internal/backoff"
+)
+
+const (
+	// pollInitialDelay is the delay before the first re-poll of pending thumbnails.
+	pollInitialDelay = 500 * time.Millisecond
+
+	// pollMaxDelay caps the delay between re-polls of pending thumbnails.
+	pollMaxDelay = 8 * time.Second
+)
+
+// pollPending calls refresh with an exponentially increasing delay for as long as pending
+// reports unresolved thumbnails, until maxWait passes or the context is done.
+// Running out of time is not an error; the caller keeps whatever was resolved.
+func pollPending(ctx context.Context, maxWait time.Duration, pending func() bool, refresh func(ctx context.Context) error) error {
+	deadline := time.Now().Add(maxWait)
+	delays := backoff.New(pollInitialDelay, pollMaxDelay, 0)
+
+	for pending() {
+		delay := delays.Delay()
+		if time.Now().Add(delay).After(deadline) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+
+		if err := refresh(ctx); err != nil {
+			return err
+		}
+
+		delays.Increase()
+	}
+
+	return nil
+}
@@ -11,6 +11,23 @@ import (
 // ResourceInterface defines the interface for thumbnail-related operations.
 type ResourceInterface interface {
 	GetBatchThumbnails(ctx context.Context, p BatchThumbnailsParams) (*types.BatchThumbnailsResponse, error)
+	GetAvatarThumbnails(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error)
+	GetAvatarHeadshots(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error)
+	GetAvatarBusts(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error)
+	GetGroupIcons(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error)
+	GetGameIcons(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error)
+	GetGameThumbnails(ctx context.Context, p GameThumbnailsParams) (*types.GameThumbnailsResponse, error)
+	GetAssetThumbnails(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error)
+	GetBadgeIcons(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error)
+	GetBundleThumbnails(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error)
+	GetGamePassIcons(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error)
+	GetDeveloperProductIcons(ctx context.Context, p ThumbnailParams) (*types.BatchThumbnailsResponse, error)
+	GetAvatar3D(ctx context.Context, userID int64) (*types.Thumbnail3DResponse, error)
+	GetAsset3D(ctx context.Context, assetID int64) (*types.Thumbnail3DResponse, error)
+	GetAvatar3DManifest(ctx context.Context, userID int64) (*types.Thumbnail3DManifest, error)
+	GetAsset3DManifest(ctx context.Context, assetID int64) (*types.Thumbnail3DManifest, error)
+	Download3DFiles(ctx context.Context, manifest *types.Thumbnail3DManifest, w FileWriter) (*Thumbnail3DFiles, error)
+	Download3DFilesToDir(ctx context.Context, manifest *types.Thumbnail3DManifest, dir string) (*Thumbnail3DFiles, error)
 }
 
 // Ensure Resource implements the ResourceInterface.
@@ -0,0 +1,29 @@
+package trades_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/trades"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanTradeWith(t *testing.T) {
+	// Create a new test resource
+	api := trades.New(utils.NewTestEnv())
+
+	t.Run("Check Known User", func(t *testing.T) {
+		result, err := api.CanTradeWith(context.Background(), utils.SampleUserID5)
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotEmpty(t, result.Status)
+	})
+
+	t.Run("Invalid User ID", func(t *testing.T) {
+		result, err := api.CanTradeWith(context.Background(), utils.InvalidUserID)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
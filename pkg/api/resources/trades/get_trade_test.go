@@ -0,0 +1,37 @@
+package trades_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/trades"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTrade(t *testing.T) {
+	// Create a new test resource
+	api := trades.New(utils.NewTestEnv())
+
+	t.Run("Fetch Trade Details From List", func(t *testing.T) {
+		list, err := api.GetTrades(context.Background(), trades.NewTradesBuilder(types.TradeStatusTypeInactive).Build())
+		require.NoError(t, err)
+
+		if len(list.Data) == 0 {
+			t.Skip("test account has no trades")
+		}
+
+		trade, err := api.GetTrade(context.Background(), list.Data[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, list.Data[0].ID, trade.ID)
+		assert.Len(t, trade.Offers, 2)
+	})
+
+	t.Run("Invalid Trade ID", func(t *testing.T) {
+		trade, err := api.GetTrade(context.Background(), 0)
+		require.Error(t, err)
+		assert.Nil(t, trade)
+	})
+}
@@ -0,0 +1,92 @@
+package trades
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// GetTrades fetches the paginated list of the authenticated user's trades with the given status.
+// GET https://trades.roblox.com/v1/trades/{tradeStatusType}
+func (r *Resource) GetTrades(ctx context.Context, p TradesParams) (*types.TradesResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+
+	var trades types.TradesResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodGet).
+		URL(fmt.Sprintf("%s/v1/trades/%s", types.TradesEndpoint, p.StatusType)).
+		Query("limit", strconv.FormatInt(p.Limit, 10)).
+		Query("cursor", p.Cursor).
+		Query("sortOrder", string(p.SortOrder)).
+		Result(&trades).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&trades); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &trades, nil
+}
+
+// TradesParams holds the parameters for listing trades.
+type TradesParams struct {
+	StatusType types.TradeStatusType `json:"tradeStatusType" validate:"required,oneof=Inbound Outbound Completed Inactive"`
+	Limit      int64                 `json:"limit"           validate:"oneof=10 25 50 100"`
+	Cursor     string                `json:"cursor"          validate:"omitempty"`
+	SortOrder  types.SortOrder       `json:"sortOrder"       validate:"omitempty,oneof=Asc Desc"`
+}
+
+// TradesBuilder is a builder for TradesParams.
+type TradesBuilder struct {
+	params TradesParams
+}
+
+// NewTradesBuilder creates a new TradesBuilder with default values.
+func NewTradesBuilder(statusType types.TradeStatusType) *TradesBuilder {
+	return &TradesBuilder{
+		params: TradesParams{
+			StatusType: statusType,
+			Limit:      10,
+			Cursor:     "",
+			SortOrder:  types.SortOrderDesc,
+		},
+	}
+}
+
+// WithLimit sets the limit.
+func (b *TradesBuilder) WithLimit(limit int64) *TradesBuilder {
+	b.params.Limit = limit
+	return b
+}
+
+// WithCursor sets the cursor.
+func (b *TradesBuilder) WithCursor(cursor string) *TradesBuilder {
+	b.params.Cursor = cursor
+	return b
+}
+
+// WithSortOrder sets the sort order.
+func (b *TradesBuilder) WithSortOrder(order types.SortOrder) *TradesBuilder {
+	b.params.SortOrder = order
+	return b
+}
+
+// Build returns the TradesParams.
+func (b *TradesBuilder) Build() TradesParams {
+	return b.params
+}
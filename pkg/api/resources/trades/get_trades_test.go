@@ -0,0 +1,51 @@
+package trades_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/trades"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTrades(t *testing.T) {
+	// Create a new test resource
+	api := trades.New(utils.NewTestEnv())
+
+	t.Run("Fetch Inbound Trades Successfully", func(t *testing.T) {
+		builder := trades.NewTradesBuilder(types.TradeStatusTypeInbound)
+		result, err := api.GetTrades(context.Background(), builder.Build())
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.Data)
+
+		for _, trade := range result.Data {
+			assert.NotZero(t, trade.ID)
+			assert.NotZero(t, trade.User.ID)
+			assert.NotEmpty(t, trade.Status)
+		}
+	})
+
+	t.Run("Invalid Status Type", func(t *testing.T) {
+		builder := trades.NewTradesBuilder("Pending")
+		_, err := api.GetTrades(context.Background(), builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "StatusType")
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := trades.NewTradesBuilder(types.TradeStatusTypeCompleted).
+			WithLimit(25).
+			WithCursor("nextPageCursor").
+			WithSortOrder(types.SortOrderAsc)
+
+		params := builder.Build()
+		assert.Equal(t, types.TradeStatusTypeCompleted, params.StatusType)
+		assert.Equal(t, int64(25), params.Limit)
+		assert.Equal(t, "nextPageCursor", params.Cursor)
+		assert.Equal(t, types.SortOrderAsc, params.SortOrder)
+	})
+}
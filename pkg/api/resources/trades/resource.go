@@ -0,0 +1,50 @@
+package trades
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+type contextKey int
+
+const (
+	// KeyDryRun makes trade write calls validate their parameters without sending the request.
+	KeyDryRun contextKey = iota
+)
+
+// ResourceInterface defines the interface for trade-related operations.
+type ResourceInterface interface {
+	GetTrades(ctx context.Context, p TradesParams) (*types.TradesResponse, error)
+	GetTrade(ctx context.Context, tradeID int64) (*types.TradeResponse, error)
+	CanTradeWith(ctx context.Context, userID int64) (*types.CanTradeWithResponse, error)
+	SendTrade(ctx context.Context, p TradeOffersParams) (*types.TradeIDResponse, error)
+	CounterTrade(ctx context.Context, tradeID int64, p TradeOffersParams) (*types.TradeIDResponse, error)
+	AcceptTrade(ctx context.Context, tradeID int64) error
+	DeclineTrade(ctx context.Context, tradeID int64) error
+}
+
+// Ensure Resource implements the ResourceInterface.
+var _ ResourceInterface = (*Resource)(nil)
+
+// Resource provides methods for interacting with trade-related endpoints.
+type Resource struct {
+	client   *client.Client
+	validate *validator.Validate
+}
+
+// New creates a new Resource with the specified client and validator.
+func New(client *client.Client, validate *validator.Validate) *Resource {
+	return &Resource{
+		client:   client,
+		validate: validate,
+	}
+}
+
+// isDryRun reports whether the context requests a dry run.
+func isDryRun(ctx context.Context) bool {
+	dryRun, ok := ctx.Value(KeyDryRun).(bool)
+	return ok && dryRun
+}
@@ -0,0 +1,51 @@
+package trades
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// AcceptTrade accepts an inbound trade.
+// If the context has KeyDryRun set, the trade ID is validated but the request is not sent.
+// POST https://trades.roblox.com/v1/trades/{tradeId}/accept
+func (r *Resource) AcceptTrade(ctx context.Context, tradeID int64) error {
+	return r.respondToTrade(ctx, tradeID, "accept")
+}
+
+// DeclineTrade declines an inbound trade or cancels an outbound one.
+// If the context has KeyDryRun set, the trade ID is validated but the request is not sent.
+// POST https://trades.roblox.com/v1/trades/{tradeId}/decline
+func (r *Resource) DeclineTrade(ctx context.Context, tradeID int64) error {
+	return r.respondToTrade(ctx, tradeID, "decline")
+}
+
+// respondToTrade sends an accept or decline action for a trade.
+func (r *Resource) respondToTrade(ctx context.Context, tradeID int64, action string) error {
+	if err := r.validate.Var(tradeID, "required,gt=0"); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	if isDryRun(ctx) {
+		return nil
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+	ctx = context.WithValue(ctx, auth.KeyAddToken, true)
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(fmt.Sprintf("%s/v1/trades/%d/%s", types.TradesEndpoint, tradeID, action)).
+		Do(ctx)
+	if err != nil {
+		return errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	return nil
+}
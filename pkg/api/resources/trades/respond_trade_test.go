@@ -0,0 +1,26 @@
+package trades_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/trades"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRespondTrade(t *testing.T) {
+	// Create a new test resource
+	api := trades.New(utils.NewTestEnv())
+	dryRun := context.WithValue(context.Background(), trades.KeyDryRun, true)
+
+	t.Run("Dry Run Accept And Decline", func(t *testing.T) {
+		require.NoError(t, api.AcceptTrade(dryRun, 1))
+		require.NoError(t, api.DeclineTrade(dryRun, 1))
+	})
+
+	t.Run("Invalid Trade ID", func(t *testing.T) {
+		require.Error(t, api.AcceptTrade(dryRun, 0))
+		require.Error(t, api.DeclineTrade(dryRun, 0))
+	})
+}
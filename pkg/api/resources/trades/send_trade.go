@@ -0,0 +1,142 @@
+package trades
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jaxron/roapi.go/pkg/api/errs"
+	"github.com/jaxron/roapi.go/pkg/api/middleware/auth"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+)
+
+// SendTrade sends a new trade to another user.
+// If the context has KeyDryRun set, the trade is validated but not sent and the returned ID is zero.
+// POST https://trades.roblox.com/v1/trades/send
+func (r *Resource) SendTrade(ctx context.Context, p TradeOffersParams) (*types.TradeIDResponse, error) {
+	return r.sendOffers(ctx, types.TradesEndpoint+"/v1/trades/send", p)
+}
+
+// CounterTrade counters an inbound trade with a new set of offers.
+// If the context has KeyDryRun set, the trade is validated but not sent and the returned ID is zero.
+// POST https://trades.roblox.com/v1/trades/{tradeId}/counter
+func (r *Resource) CounterTrade(ctx context.Context, tradeID int64, p TradeOffersParams) (*types.TradeIDResponse, error) {
+	if err := r.validate.Var(tradeID, "required,gt=0"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	return r.sendOffers(ctx, fmt.Sprintf("%s/v1/trades/%d/counter", types.TradesEndpoint, tradeID), p)
+}
+
+// sendOffers validates the offers and posts them to the given URL.
+func (r *Resource) sendOffers(ctx context.Context, url string, p TradeOffersParams) (*types.TradeIDResponse, error) {
+	if err := r.validate.Struct(p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidRequest, err)
+	}
+
+	if isDryRun(ctx) {
+		return &types.TradeIDResponse{ID: 0}, nil
+	}
+
+	ctx = context.WithValue(ctx, auth.KeyAddCookie, true)
+	ctx = context.WithValue(ctx, auth.KeyAddToken, true)
+
+	var result types.TradeIDResponse
+
+	resp, err := r.client.NewRequest().
+		Method(http.MethodPost).
+		URL(url).
+		MarshalBody(p).
+		Result(&result).
+		Do(ctx)
+	if err != nil {
+		return nil, errs.HandleAPIError(resp, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := r.validate.Struct(&result); err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidResponse, err)
+	}
+
+	return &result, nil
+}
+
+// TradeOfferRequest represents one side of a trade request.
+type TradeOfferRequest struct {
+	UserID       int64   `json:"userId"       validate:"required,gt=0"`          // ID of the user making the offer
+	UserAssetIDs []int64 `json:"userAssetIds" validate:"max=4,unique,dive,gt=0"` // User asset IDs offered (see types.InventoryAsset.UserAssetID)
+	Robux        int64   `json:"robux"        validate:"min=0"`                  // Robux offered
+}
+
+// TradeOffersParams holds the parameters for sending or countering a trade.
+// The first offer is the authenticated user's and the second is the trade partner's.
+type TradeOffersParams struct {
+	Offers []TradeOfferRequest `json:"offers" validate:"required,len=2,dive"`
+}
+
+// TradeOffersBuilder is a builder for TradeOffersParams.
+type TradeOffersBuilder struct {
+	params TradeOffersParams
+}
+
+// NewTradeOffersBuilder creates a new TradeOffersBuilder for a trade between two users.
+func NewTradeOffersBuilder(senderID, receiverID int64) *TradeOffersBuilder {
+	return &TradeOffersBuilder{
+		params: TradeOffersParams{
+			Offers: []TradeOfferRequest{
+				{UserID: senderID, UserAssetIDs: []int64{}, Robux: 0},
+				{UserID: receiverID, UserAssetIDs: []int64{}, Robux: 0},
+			},
+		},
+	}
+}
+
+// WithSenderUserAssetIDs adds user asset IDs to the sender's offer.
+func (b *TradeOffersBuilder) WithSenderUserAssetIDs(userAssetIDs ...int64) *TradeOffersBuilder {
+	b.params.Offers[0].UserAssetIDs = append(b.params.Offers[0].UserAssetIDs, userAssetIDs...)
+	return b
+}
+
+// WithReceiverUserAssetIDs adds user asset IDs to the receiver's offer.
+func (b *TradeOffersBuilder) WithReceiverUserAssetIDs(userAssetIDs ...int64) *TradeOffersBuilder {
+	b.params.Offers[1].UserAssetIDs = append(b.params.Offers[1].UserAssetIDs, userAssetIDs...)
+	return b
+}
+
+// WithSenderAssets adds inventory assets to the sender's offer using their user asset IDs.
+func (b *TradeOffersBuilder) WithSenderAssets(assets ...types.InventoryAsset) *TradeOffersBuilder {
+	return b.WithSenderUserAssetIDs(userAssetIDs(assets)...)
+}
+
+// WithReceiverAssets adds inventory assets to the receiver's offer using their user asset IDs.
+func (b *TradeOffersBuilder) WithReceiverAssets(assets ...types.InventoryAsset) *TradeOffersBuilder {
+	return b.WithReceiverUserAssetIDs(userAssetIDs(assets)...)
+}
+
+// WithSenderRobux sets the Robux offered by the sender.
+func (b *TradeOffersBuilder) WithSenderRobux(robux int64) *TradeOffersBuilder {
+	b.params.Offers[0].Robux = robux
+	return b
+}
+
+// WithReceiverRobux sets the Robux requested from the receiver.
+func (b *TradeOffersBuilder) WithReceiverRobux(robux int64) *TradeOffersBuilder {
+	b.params.Offers[1].Robux = robux
+	return b
+}
+
+// Build returns the TradeOffersParams.
+func (b *TradeOffersBuilder) Build() TradeOffersParams {
+	return b.params
+}
+
+// userAssetIDs extracts the user asset IDs from inventory assets.
+func userAssetIDs(assets []types.InventoryAsset) []int64 {
+	ids := make([]int64, len(assets))
+	for i, asset := range assets {
+		ids[i] = asset.UserAssetID
+	}
+
+	return ids
+}
@@ -0,0 +1,70 @@
+package trades_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaxron/roapi.go/internal/utils"
+	"github.com/jaxron/roapi.go/pkg/api/resources/trades"
+	"github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendTrade(t *testing.T) {
+	// Create a new test resource
+	api := trades.New(utils.NewTestEnv())
+	dryRun := context.WithValue(context.Background(), trades.KeyDryRun, true)
+
+	t.Run("Dry Run Valid Trade", func(t *testing.T) {
+		builder := trades.NewTradeOffersBuilder(utils.SampleUserID1, utils.SampleUserID2).
+			WithSenderUserAssetIDs(1001, 1002).
+			WithReceiverUserAssetIDs(2001).
+			WithSenderRobux(100)
+
+		result, err := api.SendTrade(dryRun, builder.Build())
+		require.NoError(t, err)
+		assert.Zero(t, result.ID)
+	})
+
+	t.Run("Dry Run Counter Trade", func(t *testing.T) {
+		builder := trades.NewTradeOffersBuilder(utils.SampleUserID1, utils.SampleUserID2).
+			WithReceiverUserAssetIDs(2001)
+
+		result, err := api.CounterTrade(dryRun, 1, builder.Build())
+		require.NoError(t, err)
+		assert.Zero(t, result.ID)
+	})
+
+	t.Run("Too Many Assets", func(t *testing.T) {
+		builder := trades.NewTradeOffersBuilder(utils.SampleUserID1, utils.SampleUserID2).
+			WithSenderUserAssetIDs(1, 2, 3, 4, 5)
+
+		_, err := api.SendTrade(dryRun, builder.Build())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "UserAssetIDs")
+	})
+
+	t.Run("Invalid Counter Trade ID", func(t *testing.T) {
+		builder := trades.NewTradeOffersBuilder(utils.SampleUserID1, utils.SampleUserID2)
+		_, err := api.CounterTrade(dryRun, 0, builder.Build())
+		require.Error(t, err)
+	})
+
+	t.Run("Test Builder Methods", func(t *testing.T) {
+		builder := trades.NewTradeOffersBuilder(utils.SampleUserID1, utils.SampleUserID2).
+			WithSenderAssets(types.InventoryAsset{UserAssetID: 11}, types.InventoryAsset{UserAssetID: 12}).
+			WithReceiverAssets(types.InventoryAsset{UserAssetID: 21}).
+			WithSenderRobux(50).
+			WithReceiverRobux(10)
+
+		params := builder.Build()
+		require.Len(t, params.Offers, 2)
+		assert.Equal(t, utils.SampleUserID1, params.Offers[0].UserID)
+		assert.Equal(t, []int64{11, 12}, params.Offers[0].UserAssetIDs)
+		assert.Equal(t, int64(50), params.Offers[0].Robux)
+		assert.Equal(t, utils.SampleUserID2, params.Offers[1].UserID)
+		assert.Equal(t, []int64{21}, params.Offers[1].UserAssetIDs)
+		assert.Equal(t, int64(10), params.Offers[1].Robux)
+	})
+}
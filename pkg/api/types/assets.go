@@ -0,0 +1,84 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CloudAssetType represents the type of an asset that can be uploaded through Open Cloud.
+type CloudAssetType string
+
+const (
+	CloudAssetTypeAudio CloudAssetType = "Audio"
+	CloudAssetTypeDecal CloudAssetType = "Decal"
+	CloudAssetTypeImage CloudAssetType = "Image"
+	CloudAssetTypeModel CloudAssetType = "Model"
+)
+
+// CloudAssetModerationState represents how far an asset is through moderation.
+type CloudAssetModerationState string
+
+const (
+	CloudAssetModerationStateReviewing CloudAssetModerationState = "Reviewing"
+	CloudAssetModerationStateRejected  CloudAssetModerationState = "Rejected"
+	CloudAssetModerationStateApproved  CloudAssetModerationState = "Approved"
+)
+
+// CloudAssetOperation is the long-running operation of creating or updating an asset.
+type CloudAssetOperation = Operation[CloudAsset]
+
+// CloudAsset represents an asset uploaded through Open Cloud.
+type CloudAsset struct {
+	Path               string                     `json:"path"               validate:"required"` // Resource path of the asset (e.g., "assets/123")
+	AssetID            int64                      `json:"assetId,string"     validate:"required"` // ID of the asset
+	AssetType          CloudAssetType             `json:"assetType"`                              // Type of the asset
+	DisplayName        string                     `json:"displayName"`                            // Name of the asset
+	Description        string                     `json:"description"`                            // Description of the asset
+	CreationContext    CloudAssetCreationContext  `json:"creationContext"`                        // Who created the asset
+	ModerationResult   CloudAssetModerationResult `json:"moderationResult"`                       // Moderation state of the asset
+	RevisionID         string                     `json:"revisionId"`                             // ID of the latest revision
+	RevisionCreateTime time.Time                  `json:"revisionCreateTime"`                     // When the latest revision was created
+	State              string                     `json:"state"`                                  // State of the asset (e.g., "Active")
+}
+
+// CloudAssetCreationContext represents who created an asset.
+type CloudAssetCreationContext struct {
+	Creator       CloudAssetCreator `json:"creator"`                 // User or group that owns the asset
+	ExpectedPrice int64             `json:"expectedPrice,omitempty"` // Robux the creator expects to pay for the upload
+}
+
+// CloudAssetCreator represents the owner of an asset. Exactly one of its fields is set.
+type CloudAssetCreator struct {
+	UserID  int64 `json:"userId,string,omitempty"`  // ID of the user that owns the asset
+	GroupID int64 `json:"groupId,string,omitempty"` // ID of the group that owns the asset
+}
+
+// CloudAssetModerationResult represents the moderation state of an asset.
+type CloudAssetModerationResult struct {
+	ModerationState CloudAssetModerationState `json:"moderationState"` // How far the asset is through moderation
+}
+
+// CloudAssetVersionsResponse represents a page of asset versions returned by the Open Cloud API.
+type CloudAssetVersionsResponse struct {
+	AssetVersions []CloudAssetVersion `json:"assetVersions" validate:"dive"` // List of versions on the page, newest first
+	NextPageToken string              `json:"nextPageToken"`                 // Token for the next page, empty on the last page
+}
+
+// CloudAssetVersion represents a single version of an asset.
+type CloudAssetVersion struct {
+	Path             string                     `json:"path"             validate:"required"` // Resource path of the version (e.g., "assets/123/versions/4")
+	CreationContext  CloudAssetCreationContext  `json:"creationContext"`                      // Who created the version
+	ModerationResult CloudAssetModerationResult `json:"moderationResult"`                     // Moderation state of the version
+	Published        bool                       `json:"published"`                            // Whether the version is the published one
+}
+
+// VersionNumber returns the number of the version, or 0 if the path is invalid.
+func (v *CloudAssetVersion) VersionNumber() int64 {
+	number, err := strconv.ParseInt(v.Path[strings.LastIndex(v.Path, "/")+1:], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return number
+}
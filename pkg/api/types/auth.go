@@ -0,0 +1,52 @@
+package types
+
+// CredentialType represents the kind of identifier used to log in.
+type CredentialType string
+
+const (
+	CredentialTypeUsername    CredentialType = "Username"
+	CredentialTypeEmail       CredentialType = "Email"
+	CredentialTypePhoneNumber CredentialType = "PhoneNumber"
+)
+
+// TwoStepMediaType represents the method used to deliver a two-step verification code.
+type TwoStepMediaType string
+
+const (
+	TwoStepMediaTypeAuthenticator TwoStepMediaType = "Authenticator"
+	TwoStepMediaTypeEmail         TwoStepMediaType = "Email"
+	TwoStepMediaTypeSMS           TwoStepMediaType = "SMS"
+	TwoStepMediaTypeRecoveryCode  TwoStepMediaType = "RecoveryCode"
+)
+
+// LoginResponse represents the response to a login request.
+type LoginResponse struct {
+	User                    LoginUser                `json:"user"                    validate:"required"`  // User that was logged in
+	TwoStepVerificationData *TwoStepVerificationData `json:"twoStepVerificationData" validate:"omitempty"` // Set when two-step verification is required
+	IsBanned                bool                     `json:"isBanned"`                                     // Whether the account is banned
+}
+
+// LoginUser represents the user returned by a login request.
+type LoginUser struct {
+	ID          int64  `json:"id"          validate:"required,min=1"` // Unique identifier for the user
+	Name        string `json:"name"        validate:"required"`       // Username of the user
+	DisplayName string `json:"displayName"`                           // Display name of the user
+}
+
+// TwoStepVerificationData represents a pending two-step verification challenge.
+type TwoStepVerificationData struct {
+	MediaType TwoStepMediaType `json:"mediaType" validate:"required"` // Method used to deliver the code
+	Ticket    string           `json:"ticket"    validate:"required"` // Challenge ticket to verify against
+}
+
+// TwoStepVerifyResponse represents the response to a verified two-step code.
+type TwoStepVerifyResponse struct {
+	VerificationToken string `json:"verificationToken" validate:"required"` // Token proving the challenge was solved
+}
+
+// LoginResult represents a completed login.
+type LoginResult struct {
+	User     LoginUser // User that was logged in
+	Cookie   string    // Value of the .ROBLOSECURITY cookie, ready for auth.Middleware
+	IsBanned bool      // Whether the account is banned
+}
@@ -98,3 +98,85 @@ type EmoteModel struct {
 	AssetName string `json:"assetName" validate:"required"`       // The name of the emote
 	Position  int32  `json:"position"  validate:"required"`       // The position the emote is equipped to
 }
+
+// PlayerAvatarType represents the rig type of an avatar.
+type PlayerAvatarType string
+
+const (
+	PlayerAvatarTypeR6  PlayerAvatarType = "R6"
+	PlayerAvatarTypeR15 PlayerAvatarType = "R15"
+)
+
+// AvatarOutfitType represents the kind of outfit being created.
+type AvatarOutfitType string
+
+const (
+	AvatarOutfitTypeAvatar        AvatarOutfitType = "Avatar"
+	AvatarOutfitTypeDynamicHead   AvatarOutfitType = "DynamicHead"
+	AvatarOutfitTypeShoes         AvatarOutfitType = "Shoes"
+	AvatarOutfitTypeAnimations    AvatarOutfitType = "Animations"
+	AvatarOutfitTypeBodyParts     AvatarOutfitType = "BodyParts"
+	AvatarOutfitTypeBodyPartsHead AvatarOutfitType = "BodyPartsHead"
+)
+
+// AvatarSuccessResponse represents the response of an avatar update that only reports success.
+type AvatarSuccessResponse struct {
+	Success bool `json:"success"` // Whether the update was applied
+}
+
+// WearAssetsResponse represents the response of an update that changes the worn assets.
+type WearAssetsResponse struct {
+	InvalidAssetIDs []int64 `json:"invalidAssetIds"` // Assets that could not be worn
+	Success         bool    `json:"success"`         // Whether the update was applied
+}
+
+// AvatarRulesResponse represents the avatar rules and metadata returned by the Roblox API.
+type AvatarRulesResponse struct {
+	PlayerAvatarTypes                    []PlayerAvatarType       `json:"playerAvatarTypes"                    validate:"required"`      // Supported rig types
+	Scales                               AvatarScaleRules         `json:"scales"                               validate:"required"`      // Allowed range for each scale
+	WearableAssetTypes                   []WearableAssetType      `json:"wearableAssetTypes"                   validate:"required,dive"` // Asset types that can be worn and how many of each
+	BodyColorsPalette                    []BodyColorPaletteEntry  `json:"bodyColorsPalette"                    validate:"dive"`          // Full body color palette
+	BasicBodyColorsPalette               []BodyColorPaletteEntry  `json:"basicBodyColorsPalette"               validate:"dive"`          // Basic body color palette
+	MinimumDeltaEBodyColorDifference     float64                  `json:"minimumDeltaEBodyColorDifference"`                              // Minimum color difference between head and torso
+	ProportionsAndBodyTypeEnabledForUser bool                     `json:"proportionsAndBodyTypeEnabledForUser"`                          // Whether the proportion and body type scales can be changed
+	DefaultClothingAssetLists            DefaultClothingAssetList `json:"defaultClothingAssetLists"`                                     // Clothing applied when none is worn
+	BundlesEnabledForUser                bool                     `json:"bundlesEnabledForUser"`                                         // Whether bundles are enabled
+	EmotesEnabledForUser                 bool                     `json:"emotesEnabledForUser"`                                          // Whether emotes are enabled
+}
+
+// AvatarScaleRules represents the allowed range of each avatar scale.
+type AvatarScaleRules struct {
+	Height     ScaleRule `json:"height"`     // Height scale range
+	Width      ScaleRule `json:"width"`      // Width scale range
+	Head       ScaleRule `json:"head"`       // Head scale range
+	Depth      ScaleRule `json:"depth"`      // Depth scale range
+	Proportion ScaleRule `json:"proportion"` // Proportion scale range
+	BodyType   ScaleRule `json:"bodyType"`   // Body type scale range
+}
+
+// ScaleRule represents the allowed range of a single avatar scale.
+type ScaleRule struct {
+	Min       float64 `json:"min"`       // Minimum value
+	Max       float64 `json:"max"`       // Maximum value
+	Increment float64 `json:"increment"` // Step between allowed values
+}
+
+// WearableAssetType represents an asset type that can be worn and its wear limit.
+type WearableAssetType struct {
+	ID        ItemAssetType `json:"id"        validate:"required,min=1"` // Asset type ID
+	Name      string        `json:"name"      validate:"required"`       // Asset type name
+	MaxNumber int64         `json:"maxNumber"`                           // Maximum number of assets of this type that can be worn
+}
+
+// BodyColorPaletteEntry represents a single color in the body color palette.
+type BodyColorPaletteEntry struct {
+	BrickColorID int64  `json:"brickColorId"`                     // Brick color ID
+	HexColor     string `json:"hexColor"     validate:"required"` // Color in hex
+	Name         string `json:"name"`                             // Color name
+}
+
+// DefaultClothingAssetList represents the clothing applied when a user wears none.
+type DefaultClothingAssetList struct {
+	DefaultShirtAssetIDs []int64 `json:"defaultShirtAssetIds"` // Default shirt asset IDs
+	DefaultPantAssetIDs  []int64 `json:"defaultPantAssetIds"`  // Default pants asset IDs
+}
@@ -0,0 +1,67 @@
+package types
+
+import "time"
+
+// BadgeResponse represents the structure of badge information returned by the Roblox API.
+type BadgeResponse struct {
+	ID                 int64           `json:"id"                 validate:"required,min=1"` // Unique identifier for the badge
+	Name               string          `json:"name"               validate:"required"`       // Name of the badge
+	Description        string          `json:"description"`                                  // Description of the badge
+	DisplayName        string          `json:"displayName"`                                  // Localized display name of the badge
+	DisplayDescription string          `json:"displayDescription"`                           // Localized display description of the badge
+	Enabled            bool            `json:"enabled"`                                      // Whether the badge can be awarded
+	IconImageID        int64           `json:"iconImageId"`                                  // Asset ID of the badge icon
+	DisplayIconImageID int64           `json:"displayIconImageId"`                           // Asset ID of the localized badge icon
+	Created            time.Time       `json:"created"            validate:"required"`       // When the badge was created
+	Updated            time.Time       `json:"updated"            validate:"required"`       // When the badge was last updated
+	Statistics         BadgeStatistics `json:"statistics"`                                   // Award statistics for the badge
+	AwardingUniverse   *BadgeUniverse  `json:"awardingUniverse"   validate:"omitempty"`      // Universe that awards the badge
+	Awarder            *BadgeAwarder   `json:"awarder,omitempty"  validate:"omitempty"`      // Awarder of the badge (present in user badge lists)
+}
+
+// BadgeStatistics represents the award statistics of a badge.
+type BadgeStatistics struct {
+	PastDayAwardedCount int64   `json:"pastDayAwardedCount" validate:"min=0"` // Number of times the badge was awarded in the past day
+	AwardedCount        int64   `json:"awardedCount"        validate:"min=0"` // Total number of times the badge was awarded
+	WinRatePercentage   float64 `json:"winRatePercentage"`                    // Percentage of players who earned the badge
+}
+
+// BadgeUniverse represents the universe that awards a badge.
+type BadgeUniverse struct {
+	ID          int64  `json:"id"          validate:"required,min=1"` // Universe ID
+	Name        string `json:"name"`                                  // Universe name
+	RootPlaceID int64  `json:"rootPlaceId"`                           // Root place ID of the universe
+}
+
+// BadgeAwarder represents the entity that awarded a badge.
+type BadgeAwarder struct {
+	ID   int64  `json:"id"   validate:"required,min=1"` // Awarder's unique identifier
+	Type string `json:"type" validate:"required"`       // Type of awarder (e.g., "Place")
+	Name string `json:"name"`                           // Name of the awarder
+}
+
+// BadgePageResponse represents the structure of a paginated badge list returned by the Roblox API.
+type BadgePageResponse struct {
+	PreviousPageCursor *string         `json:"previousPageCursor" validate:"omitempty"`     // Cursor for the previous page of results (if any)
+	NextPageCursor     *string         `json:"nextPageCursor"     validate:"omitempty"`     // Cursor for the next page of results (if any)
+	Data               []BadgeResponse `json:"data"               validate:"required,dive"` // List of badges
+}
+
+// BadgeAwardedDatesResponse represents the structure of badge awarded dates returned by the Roblox API.
+type BadgeAwardedDatesResponse struct {
+	Data []BadgeAwardedDate `json:"data" validate:"required,dive"` // List of awarded dates
+}
+
+// BadgeAwardedDate represents when a user was awarded a single badge.
+type BadgeAwardedDate struct {
+	BadgeID     int64     `json:"badgeId"     validate:"required,min=1"` // Unique identifier for the badge
+	AwardedDate time.Time `json:"awardedDate" validate:"required"`       // When the badge was awarded
+}
+
+// BadgeSortBy represents the field used to sort a universe's badges.
+type BadgeSortBy string
+
+const (
+	BadgeSortByRank        BadgeSortBy = "Rank"
+	BadgeSortByDateCreated BadgeSortBy = "DateCreated"
+)
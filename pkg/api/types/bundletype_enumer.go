@@ -0,0 +1,91 @@
+// Code generated by "enumer -type=BundleType -trimprefix=BundleType"; DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _BundleTypeName = "BodyPartsAvatarAnimationsShoesDynamicHeadDynamicHeadAvatar"
+
+var _BundleTypeIndex = [...]uint8{0, 9, 25, 30, 41, 58}
+
+const _BundleTypeLowerName = "bodypartsavataranimationsshoesdynamicheaddynamicheadavatar"
+
+func (i BundleType) String() string {
+	i -= 1
+	if i < 0 || i >= BundleType(len(_BundleTypeIndex)-1) {
+		return fmt.Sprintf("BundleType(%d)", i+1)
+	}
+	return _BundleTypeName[_BundleTypeIndex[i]:_BundleTypeIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _BundleTypeNoOp() {
+	var x [1]struct{}
+	_ = x[BundleTypeBodyParts-(1)]
+	_ = x[BundleTypeAvatarAnimations-(2)]
+	_ = x[BundleTypeShoes-(3)]
+	_ = x[BundleTypeDynamicHead-(4)]
+	_ = x[BundleTypeDynamicHeadAvatar-(5)]
+}
+
+var _BundleTypeValues = []BundleType{BundleTypeBodyParts, BundleTypeAvatarAnimations, BundleTypeShoes, BundleTypeDynamicHead, BundleTypeDynamicHeadAvatar}
+
+var _BundleTypeNameToValueMap = map[string]BundleType{
+	_BundleTypeName[0:9]:        BundleTypeBodyParts,
+	_BundleTypeLowerName[0:9]:   BundleTypeBodyParts,
+	_BundleTypeName[9:25]:       BundleTypeAvatarAnimations,
+	_BundleTypeLowerName[9:25]:  BundleTypeAvatarAnimations,
+	_BundleTypeName[25:30]:      BundleTypeShoes,
+	_BundleTypeLowerName[25:30]: BundleTypeShoes,
+	_BundleTypeName[30:41]:      BundleTypeDynamicHead,
+	_BundleTypeLowerName[30:41]: BundleTypeDynamicHead,
+	_BundleTypeName[41:58]:      BundleTypeDynamicHeadAvatar,
+	_BundleTypeLowerName[41:58]: BundleTypeDynamicHeadAvatar,
+}
+
+var _BundleTypeNames = []string{
+	_BundleTypeName[0:9],
+	_BundleTypeName[9:25],
+	_BundleTypeName[25:30],
+	_BundleTypeName[30:41],
+	_BundleTypeName[41:58],
+}
+
+// BundleTypeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func BundleTypeString(s string) (BundleType, error) {
+	if val, ok := _BundleTypeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _BundleTypeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to BundleType values", s)
+}
+
+// BundleTypeValues returns all values of the enum
+func BundleTypeValues() []BundleType {
+	return _BundleTypeValues
+}
+
+// BundleTypeStrings returns a slice of all String values of the enum
+func BundleTypeStrings() []string {
+	strs := make([]string, len(_BundleTypeNames))
+	copy(strs, _BundleTypeNames)
+	return strs
+}
+
+// IsABundleType returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i BundleType) IsABundleType() bool {
+	for _, v := range _BundleTypeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // CatalogItemType represents the type of item in a catalog request.
 type CatalogItemType string
 
@@ -18,7 +23,7 @@ type CatalogItem struct {
 	ID                           int64         `json:"id"                                     validate:"required,min=1"` // Unique identifier for the item
 	ItemType                     string        `json:"itemType"                               validate:"required"`       // Item type ("Asset" or "Bundle")
 	AssetType                    *int64        `json:"assetType,omitempty"`                                              // Asset type ID (present for assets)
-	BundleType                   *int64        `json:"bundleType,omitempty"`                                             // Bundle type ID (present for bundles)
+	BundleType                   *BundleType   `json:"bundleType,omitempty"`                                             // Bundle type (present for bundles)
 	Name                         string        `json:"name"                                   validate:"required"`       // Item name
 	Description                  string        `json:"description"`                                                      // Item description
 	ProductID                    *int64        `json:"productId,omitempty"`                                              // Product ID (absent for off-sale items)
@@ -58,6 +63,54 @@ type BundledItem struct {
 	AssetType          int64  `json:"assetType"          validate:"required,min=1"` // Asset type ID
 }
 
+// BundleDetailsItem represents a single item within a bundle returned by the bundle endpoints.
+// Unlike BundledItem, it has no asset type for outfits.
+type BundleDetailsItem struct {
+	Owned              bool   `json:"owned"`                                         // Whether the user owns this bundled item
+	ID                 int64  `json:"id"                 validate:"required,min=1"`  // Bundled item ID
+	Name               string `json:"name"               validate:"required"`        // Bundled item name
+	Type               string `json:"type"               validate:"required"`        // Bundled item type
+	SupportsHeadShapes bool   `json:"supportsHeadShapes"`                            // Whether the bundled item supports head shapes
+	AssetType          int64  `json:"assetType"          validate:"omitempty,min=1"` // Asset type ID (absent for outfits)
+}
+
+// BundleType represents the type of a catalog bundle.
+//
+//go:generate go tool enumer -type=BundleType -trimprefix=BundleType
+type BundleType int64
+
+const (
+	BundleTypeBodyParts         BundleType = 1
+	BundleTypeAvatarAnimations  BundleType = 2
+	BundleTypeShoes             BundleType = 3
+	BundleTypeDynamicHead       BundleType = 4
+	BundleTypeDynamicHeadAvatar BundleType = 5
+)
+
+// UnmarshalJSON decodes a bundle type from either its numeric ID or its name,
+// as the catalog endpoints are inconsistent about which form they return.
+func (i *BundleType) UnmarshalJSON(data []byte) error {
+	var id int64
+	if err := json.Unmarshal(data, &id); err == nil {
+		*i = BundleType(id)
+		return nil
+	}
+
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return fmt.Errorf("BundleType should be a number or a string, got %s", data)
+	}
+
+	v, err := BundleTypeString(name)
+	if err != nil {
+		return err
+	}
+
+	*i = v
+
+	return nil
+}
+
 // Taxonomy represents a category classification for a catalog item.
 type Taxonomy struct {
 	TaxonomyID   string `json:"taxonomyId"   validate:"required"` // Unique taxonomy identifier
@@ -70,3 +123,181 @@ type TimedOption struct {
 	Price    int64 `json:"price"`    // Price for this duration
 	Selected bool  `json:"selected"` // Whether this option is selected
 }
+
+// CatalogCategory represents a top-level catalog search category.
+type CatalogCategory string
+
+const (
+	CatalogCategoryAll                CatalogCategory = "All"
+	CatalogCategoryFeatured           CatalogCategory = "Featured"
+	CatalogCategoryCollectibles       CatalogCategory = "Collectibles"
+	CatalogCategoryClothing           CatalogCategory = "Clothing"
+	CatalogCategoryBodyParts          CatalogCategory = "BodyParts"
+	CatalogCategoryGear               CatalogCategory = "Gear"
+	CatalogCategoryAccessories        CatalogCategory = "Accessories"
+	CatalogCategoryAvatarAnimations   CatalogCategory = "AvatarAnimations"
+	CatalogCategoryCommunityCreations CatalogCategory = "CommunityCreations"
+)
+
+// CatalogSubcategory represents a catalog search subcategory.
+type CatalogSubcategory string
+
+const (
+	CatalogSubcategoryAll                   CatalogSubcategory = "All"
+	CatalogSubcategoryCollectibles          CatalogSubcategory = "Collectibles"
+	CatalogSubcategoryClothing              CatalogSubcategory = "Clothing"
+	CatalogSubcategoryBodyParts             CatalogSubcategory = "BodyParts"
+	CatalogSubcategoryGear                  CatalogSubcategory = "Gear"
+	CatalogSubcategoryHats                  CatalogSubcategory = "Hats"
+	CatalogSubcategoryFaces                 CatalogSubcategory = "Faces"
+	CatalogSubcategoryShirts                CatalogSubcategory = "Shirts"
+	CatalogSubcategoryTShirts               CatalogSubcategory = "TShirts"
+	CatalogSubcategoryPants                 CatalogSubcategory = "Pants"
+	CatalogSubcategoryHeads                 CatalogSubcategory = "Heads"
+	CatalogSubcategoryAccessories           CatalogSubcategory = "Accessories"
+	CatalogSubcategoryHairAccessories       CatalogSubcategory = "HairAccessories"
+	CatalogSubcategoryFaceAccessories       CatalogSubcategory = "FaceAccessories"
+	CatalogSubcategoryNeckAccessories       CatalogSubcategory = "NeckAccessories"
+	CatalogSubcategoryShoulderAccessories   CatalogSubcategory = "ShoulderAccessories"
+	CatalogSubcategoryFrontAccessories      CatalogSubcategory = "FrontAccessories"
+	CatalogSubcategoryBackAccessories       CatalogSubcategory = "BackAccessories"
+	CatalogSubcategoryWaistAccessories      CatalogSubcategory = "WaistAccessories"
+	CatalogSubcategoryAvatarAnimations      CatalogSubcategory = "AvatarAnimations"
+	CatalogSubcategoryEmoteAnimations       CatalogSubcategory = "EmoteAnimations"
+	CatalogSubcategoryBundles               CatalogSubcategory = "Bundles"
+	CatalogSubcategoryAnimationBundles      CatalogSubcategory = "AnimationBundles"
+	CatalogSubcategoryClassicShirts         CatalogSubcategory = "ClassicShirts"
+	CatalogSubcategoryClassicTShirts        CatalogSubcategory = "ClassicTShirts"
+	CatalogSubcategoryClassicPants          CatalogSubcategory = "ClassicPants"
+	CatalogSubcategoryDynamicHeads          CatalogSubcategory = "DynamicHeads"
+	CatalogSubcategoryLayeredClothing       CatalogSubcategory = "LayeredClothing"
+	CatalogSubcategoryShoesBundles          CatalogSubcategory = "ShoesBundles"
+	CatalogSubcategoryCommunityCreations    CatalogSubcategory = "CommunityCreations"
+	CatalogSubcategoryAvatarEmoteAnimations CatalogSubcategory = "AvatarEmoteAnimations"
+)
+
+// CatalogCreatorType represents the type of creator to filter a catalog search by.
+type CatalogCreatorType string
+
+const (
+	CatalogCreatorTypeUser  CatalogCreatorType = "User"
+	CatalogCreatorTypeGroup CatalogCreatorType = "Group"
+)
+
+// CatalogSalesType represents the sales type filter of a catalog search.
+type CatalogSalesType int64
+
+const (
+	CatalogSalesTypeAll          CatalogSalesType = 1
+	CatalogSalesTypeCollectibles CatalogSalesType = 2
+	CatalogSalesTypePremium      CatalogSalesType = 3
+)
+
+// CatalogSortType represents the sort order of a catalog search.
+type CatalogSortType int64
+
+const (
+	CatalogSortTypeRelevance       CatalogSortType = 0
+	CatalogSortTypeMostFavorited   CatalogSortType = 1
+	CatalogSortTypeBestSelling     CatalogSortType = 2
+	CatalogSortTypeRecentlyUpdated CatalogSortType = 3
+	CatalogSortTypePriceLowToHigh  CatalogSortType = 4
+	CatalogSortTypePriceHighToLow  CatalogSortType = 5
+)
+
+// CatalogSortAggregation represents the time window used by popularity-based sort types.
+type CatalogSortAggregation int64
+
+const (
+	CatalogSortAggregationPastDay  CatalogSortAggregation = 1
+	CatalogSortAggregationPastWeek CatalogSortAggregation = 3
+	CatalogSortAggregationAllTime  CatalogSortAggregation = 5
+)
+
+// CatalogSearchResponse represents a page of catalog search results.
+type CatalogSearchResponse struct {
+	Keyword            *string        `json:"keyword"`                                     // Keyword the results were matched against
+	PreviousPageCursor *string        `json:"previousPageCursor" validate:"omitempty"`     // Cursor for the previous page of results (if any)
+	NextPageCursor     *string        `json:"nextPageCursor"     validate:"omitempty"`     // Cursor for the next page of results (if any)
+	Data               []*CatalogItem `json:"data"               validate:"required,dive"` // List of catalog items
+}
+
+// BundleDetails represents a single bundle returned by the bundle endpoints.
+type BundleDetails struct {
+	ID          int64               `json:"id"          validate:"required,min=1"` // Unique identifier for the bundle
+	Name        string              `json:"name"        validate:"required"`       // Bundle name
+	Description string              `json:"description"`                           // Bundle description
+	BundleType  BundleType          `json:"bundleType"  validate:"required"`       // Bundle type
+	Items       []BundleDetailsItem `json:"items"       validate:"dive"`           // Items included in the bundle
+	Creator     BundleCreator       `json:"creator"`                               // Creator of the bundle
+	Product     *BundleProduct      `json:"product"`                               // Product information (absent for some owned bundles)
+}
+
+// BundleCreator represents the creator of a bundle.
+type BundleCreator struct {
+	ID               int64  `json:"id"               validate:"required,min=1"` // Creator's user or group ID
+	Name             string `json:"name"             validate:"required"`       // Creator's name
+	Type             string `json:"type"             validate:"required"`       // Creator type ("User" or "Group")
+	HasVerifiedBadge bool   `json:"hasVerifiedBadge"`                           // Whether the creator has a verified badge
+}
+
+// BundleProduct represents the purchasable product of a bundle.
+type BundleProduct struct {
+	ID             int64   `json:"id"`             // Product ID
+	Type           string  `json:"type"`           // Product type
+	IsPublicDomain bool    `json:"isPublicDomain"` // Whether the bundle is free to take
+	IsForSale      bool    `json:"isForSale"`      // Whether the bundle is on sale
+	PriceInRobux   *int64  `json:"priceInRobux"`   // Price in Robux (absent for off-sale bundles)
+	IsFree         bool    `json:"isFree"`         // Whether the bundle is free
+	NoPriceText    *string `json:"noPriceText"`    // Text displayed when there is no price
+}
+
+// BundlePageResponse represents a paginated list of bundles.
+type BundlePageResponse struct {
+	PreviousPageCursor *string         `json:"previousPageCursor" validate:"omitempty"`     // Cursor for the previous page of results (if any)
+	NextPageCursor     *string         `json:"nextPageCursor"     validate:"omitempty"`     // Cursor for the next page of results (if any)
+	Data               []BundleDetails `json:"data"               validate:"required,dive"` // List of bundles
+}
+
+// BundleRecommendationsResponse represents the bundles recommended alongside a bundle.
+type BundleRecommendationsResponse struct {
+	Data []BundleDetails `json:"data" validate:"required,dive"` // List of recommended bundles
+}
+
+// AssetRecommendationsResponse represents the assets recommended alongside an asset.
+type AssetRecommendationsResponse struct {
+	Data []AssetRecommendation `json:"data" validate:"required,dive"` // List of recommended assets
+}
+
+// AssetRecommendation represents a single recommended asset.
+type AssetRecommendation struct {
+	Item    AssetRecommendationItem    `json:"item"`    // Recommended asset
+	Creator AssetRecommendationCreator `json:"creator"` // Creator of the asset
+	Product AssetRecommendationProduct `json:"product"` // Product information of the asset
+}
+
+// AssetRecommendationItem represents the asset in a recommendation.
+type AssetRecommendationItem struct {
+	AssetID      int64  `json:"assetId"      validate:"required,min=1"` // Unique identifier for the asset
+	Name         string `json:"name"         validate:"required"`       // Asset name
+	Price        *int64 `json:"price"`                                  // Price in Robux (absent for off-sale assets)
+	PremiumPrice *int64 `json:"premiumPrice"`                           // Premium price in Robux (if any)
+	AbsoluteURL  string `json:"absoluteUrl"`                            // URL of the asset's catalog page
+}
+
+// AssetRecommendationCreator represents the creator of a recommended asset.
+type AssetRecommendationCreator struct {
+	CreatorID   int64  `json:"creatorId"   validate:"required,min=1"` // Creator's user or group ID
+	CreatorType string `json:"creatorType" validate:"required"`       // Creator type ("User" or "Group")
+	Name        string `json:"name"`                                  // Creator's name
+}
+
+// AssetRecommendationProduct represents the purchasable product of a recommended asset.
+type AssetRecommendationProduct struct {
+	ID             int64   `json:"id"`             // Product ID
+	PriceInRobux   *int64  `json:"priceInRobux"`   // Price in Robux (absent for off-sale assets)
+	IsForSale      bool    `json:"isForSale"`      // Whether the asset is on sale
+	IsPublicDomain bool    `json:"isPublicDomain"` // Whether the asset is free to take
+	IsFree         bool    `json:"isFree"`         // Whether the asset is free
+	NoPriceText    *string `json:"noPriceText"`    // Text displayed when there is no price
+}
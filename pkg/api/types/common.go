@@ -1,16 +1,31 @@
 package types
 
+import (
+	"strconv"
+	"time"
+)
+
 // Constants for Roblox API endpoints.
 const (
-	UsersEndpoint      = "https://users.roblox.com"
-	FriendsEndpoint    = "https://friends.roblox.com"
-	GroupsEndpoint     = "https://groups.roblox.com"
-	ThumbnailsEndpoint = "https://thumbnails.roblox.com"
-	AvatarEndpoint     = "https://avatar.roblox.com"
-	PresenceEndpoint   = "https://presence.roblox.com"
-	GamesEndpoint      = "https://games.roblox.com"
-	InventoryEndpoint  = "https://inventory.roblox.com"
-	CatalogEndpoint    = "https://catalog.roblox.com"
+	UsersEndpoint               = "https://users.roblox.com"
+	FriendsEndpoint             = "https://friends.roblox.com"
+	GroupsEndpoint              = "https://groups.roblox.com"
+	ThumbnailsEndpoint          = "https://thumbnails.roblox.com"
+	AvatarEndpoint              = "https://avatar.roblox.com"
+	PresenceEndpoint            = "https://presence.roblox.com"
+	GamesEndpoint               = "https://games.roblox.com"
+	InventoryEndpoint           = "https://inventory.roblox.com"
+	CatalogEndpoint             = "https://catalog.roblox.com"
+	BadgesEndpoint              = "https://badges.roblox.com"
+	EconomyEndpoint             = "https://economy.roblox.com"
+	TradesEndpoint              = "https://trades.roblox.com"
+	PrivateMessagesEndpoint     = "https://privatemessages.roblox.com"
+	AuthEndpoint                = "https://auth.roblox.com"
+	TwoStepVerificationEndpoint = "https://twostepverification.roblox.com"
+	ApisEndpoint                = "https://apis.roblox.com"
+	OpenCloudEndpoint           = "https://apis.roblox.com/cloud/v2"
+	OpenCloudAssetsEndpoint     = "https://apis.roblox.com/assets/v1"
+	RealtimeEndpoint            = "wss://realtime-signalr.roblox.com/userhub"
 )
 
 // SortOrder represents the sort order of the results.
@@ -20,3 +35,23 @@ const (
 	SortOrderAsc  SortOrder = "Asc"
 	SortOrderDesc SortOrder = "Desc"
 )
+
+// ParseCloudDuration parses a duration encoded by Open Cloud (e.g., "3600s"), treating an
+// empty string as 0.
+func ParseCloudDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	return time.ParseDuration(s)
+}
+
+// FormatCloudDuration encodes a duration the way Open Cloud expects it (e.g., "3600s"),
+// or as an empty string if it is 0.
+func FormatCloudDuration(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
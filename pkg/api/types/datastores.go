@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DataStoreEntryState represents whether a data store entry exists or was deleted.
+type DataStoreEntryState string
+
+const (
+	DataStoreEntryStateActive  DataStoreEntryState = "ACTIVE"
+	DataStoreEntryStateDeleted DataStoreEntryState = "DELETED"
+)
+
+// DataStoresResponse represents a page of data stores returned by the Open Cloud API.
+type DataStoresResponse struct {
+	DataStores    []DataStore `json:"dataStores"    validate:"dive"` // List of data stores on the page
+	NextPageToken string      `json:"nextPageToken"`                 // Token for the next page, empty on the last page
+}
+
+// DataStore represents a standard data store of an experience.
+type DataStore struct {
+	Path       string    `json:"path"       validate:"required"` // Resource path of the data store
+	ID         string    `json:"id"         validate:"required"` // Name of the data store
+	CreateTime time.Time `json:"createTime"`                     // When the data store was created
+	State      string    `json:"state"`                          // State of the data store (e.g., "ACTIVE")
+}
+
+// DataStoreEntriesResponse represents a page of data store entries or entry revisions.
+type DataStoreEntriesResponse struct {
+	DataStoreEntries []DataStoreEntry `json:"dataStoreEntries" validate:"dive"` // List of entries on the page
+	NextPageToken    string           `json:"nextPageToken"`                    // Token for the next page, empty on the last page
+}
+
+// DataStoreEntry represents a data store entry, or one revision of it.
+// Entries returned by list methods only have their path and ID set.
+type DataStoreEntry struct {
+	Path               string              `json:"path"               validate:"required"` // Resource path of the entry, including the revision if fetched by revision
+	ID                 string              `json:"id"`                                     // Key of the entry
+	CreateTime         time.Time           `json:"createTime"`                             // When the entry was created
+	RevisionID         string              `json:"revisionId"`                             // ID of the revision
+	RevisionCreateTime time.Time           `json:"revisionCreateTime"`                     // When the revision was created
+	State              DataStoreEntryState `json:"state"`                                  // Whether the entry exists or was deleted
+	Etag               string              `json:"etag"`                                   // Checksum of the revision, for optimistic concurrency
+	Value              json.RawMessage     `json:"value"`                                  // JSON value of the entry
+	Users              []string            `json:"users"`                                  // Paths of the users associated with the entry (e.g., "users/123")
+	Attributes         map[string]any      `json:"attributes"`                             // Custom metadata of the entry
+}
+
+// UserIDs returns the IDs of the users associated with the entry.
+func (e *DataStoreEntry) UserIDs() []int64 {
+	ids := make([]int64, 0, len(e.Users))
+
+	for _, user := range e.Users {
+		id, err := strconv.ParseInt(strings.TrimPrefix(user, "users/"), 10, 64)
+		if err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
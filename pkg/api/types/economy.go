@@ -0,0 +1,361 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ResaleDataResponse represents the resale data of a limited asset returned by the Roblox API.
+type ResaleDataResponse struct {
+	AssetStock         *int64            `json:"assetStock"`                          // Total number of copies (nil for unlimited stock)
+	Sales              int64             `json:"sales"              validate:"min=0"` // Total number of sales
+	NumberRemaining    *int64            `json:"numberRemaining"`                     // Number of copies remaining for sale
+	RecentAveragePrice int64             `json:"recentAveragePrice" validate:"min=0"` // Recent average price in Robux
+	OriginalPrice      *int64            `json:"originalPrice"`                       // Original price in Robux
+	PriceDataPoints    []ResaleDataPoint `json:"priceDataPoints"    validate:"dive"`  // Daily average sale prices
+	VolumeDataPoints   []ResaleDataPoint `json:"volumeDataPoints"   validate:"dive"`  // Daily sale volumes
+}
+
+// ResaleDataPoint represents a single point in a resale time series.
+type ResaleDataPoint struct {
+	Value int64     `json:"value" validate:"min=0"`    // Price in Robux or number of sales
+	Date  time.Time `json:"date"  validate:"required"` // Day the data point applies to
+}
+
+// ResellersResponse represents the structure of a limited asset's resellers returned by the Roblox API.
+type ResellersResponse struct {
+	PreviousPageCursor *string    `json:"previousPageCursor" validate:"omitempty"`     // Cursor for the previous page of results (if any)
+	NextPageCursor     *string    `json:"nextPageCursor"     validate:"omitempty"`     // Cursor for the next page of results (if any)
+	Data               []Reseller `json:"data"               validate:"required,dive"` // List of resale listings
+}
+
+// Reseller represents a single resale listing of a limited asset.
+type Reseller struct {
+	UserAssetID  int64          `json:"userAssetId"  validate:"required,min=1"` // Unique identifier for the user's copy of the asset
+	Seller       ResellerSeller `json:"seller"       validate:"required"`       // Seller of the copy
+	Price        int64          `json:"price"        validate:"min=0"`          // Price in Robux
+	SerialNumber *int64         `json:"serialNumber"`                           // Serial number of the copy (if any)
+}
+
+// ResellerSeller represents the seller of a resale listing.
+type ResellerSeller struct {
+	ID               int64  `json:"id"               validate:"required,min=1"` // Seller's unique identifier
+	Type             string `json:"type"             validate:"required"`       // Type of seller (e.g., "User")
+	Name             string `json:"name"             validate:"required"`       // Name of the seller
+	HasVerifiedBadge bool   `json:"hasVerifiedBadge"`                           // Whether the seller has a verified badge
+}
+
+// CollectibleItemInstancesResponse represents the structure of collectible item instances returned by the Roblox API.
+type CollectibleItemInstancesResponse struct {
+	ItemInstances  []CollectibleItemInstance `json:"itemInstances"  validate:"required,dive"` // List of owned instances
+	NextPageCursor *string                   `json:"nextPageCursor" validate:"omitempty"`     // Cursor for the next page of results (if any)
+}
+
+// CollectibleItemInstance represents a single owned instance of a collectible item.
+type CollectibleItemInstance struct {
+	CollectibleInstanceID string `json:"collectibleInstanceId" validate:"required,uuid"` // Unique identifier for the instance
+	CollectibleItemID     string `json:"collectibleItemId"     validate:"required,uuid"` // Unique identifier for the collectible item
+	CollectibleProductID  string `json:"collectibleProductId"  validate:"omitempty"`     // Product identifier of the instance's listing
+	SerialNumber          *int64 `json:"serialNumber"`                                   // Serial number of the instance (if any)
+	IsHeld                bool   `json:"isHeld"`                                         // Whether the instance is on hold and cannot be resold
+	SaleState             string `json:"saleState"`                                      // Sale state of the instance (e.g., "OnSale", "OffSale")
+	Price                 *int64 `json:"price"`                                          // Listing price in Robux (if on sale)
+	UserAssetID           *int64 `json:"userAssetId"`                                    // Corresponding user asset ID (if any)
+}
+
+// CurrencyResponse represents the Robux balance of a user or group.
+type CurrencyResponse struct {
+	Robux int64 `json:"robux" validate:"min=0"` // Current Robux balance
+}
+
+// TransactionType represents the type of an economy transaction.
+type TransactionType string
+
+const (
+	TransactionTypePurchase                 TransactionType = "Purchase"
+	TransactionTypeSale                     TransactionType = "Sale"
+	TransactionTypeAffiliateSale            TransactionType = "AffiliateSale"
+	TransactionTypeDevEx                    TransactionType = "DevEx"
+	TransactionTypeGroupPayout              TransactionType = "GroupPayout"
+	TransactionTypeAdImpressionPayout       TransactionType = "AdImpressionPayout"
+	TransactionTypeCurrencyPurchase         TransactionType = "CurrencyPurchase"
+	TransactionTypeTradeRobux               TransactionType = "TradeRobux"
+	TransactionTypePremiumStipend           TransactionType = "PremiumStipend"
+	TransactionTypeEngagementPayout         TransactionType = "EngagementPayout"
+	TransactionTypeGroupEngagementPayout    TransactionType = "GroupEngagementPayout"
+	TransactionTypeAdSpend                  TransactionType = "AdSpend"
+	TransactionTypePublishingAdvanceRebates TransactionType = "PublishingAdvanceRebates"
+	TransactionTypeAffiliatePayout          TransactionType = "AffiliatePayout"
+)
+
+// TransactionTimeFrame represents the time frame used for transaction totals.
+type TransactionTimeFrame string
+
+const (
+	TransactionTimeFrameDay   TransactionTimeFrame = "Day"
+	TransactionTimeFrameWeek  TransactionTimeFrame = "Week"
+	TransactionTimeFrameMonth TransactionTimeFrame = "Month"
+	TransactionTimeFrameYear  TransactionTimeFrame = "Year"
+)
+
+// TransactionsResponse represents the structure of a paginated transaction history returned by the Roblox API.
+type TransactionsResponse struct {
+	PreviousPageCursor *string       `json:"previousPageCursor" validate:"omitempty"`     // Cursor for the previous page of results (if any)
+	NextPageCursor     *string       `json:"nextPageCursor"     validate:"omitempty"`     // Cursor for the next page of results (if any)
+	Data               []Transaction `json:"data"               validate:"required,dive"` // List of transactions
+}
+
+// Transaction represents a single economy transaction.
+type Transaction struct {
+	ID              int64               `json:"id"              validate:"required,min=1"` // Unique identifier for the transaction
+	IDHash          string              `json:"idHash"`                                    // Hashed identifier for the transaction
+	TransactionType TransactionType     `json:"transactionType"`                           // Type of the transaction
+	Created         time.Time           `json:"created"         validate:"required"`       // When the transaction was created
+	IsPending       bool                `json:"isPending"`                                 // Whether the Robux are still pending
+	Agent           TransactionAgent    `json:"agent"           validate:"required"`       // Other party of the transaction
+	Currency        TransactionCurrency `json:"currency"        validate:"required"`       // Amount and currency of the transaction
+	PurchaseToken   *string             `json:"purchaseToken"`                             // Purchase token (if any)
+	RawDetails      json.RawMessage     `json:"details"`                                   // Undecoded details payload
+	Details         TransactionDetails  `json:"-"`                                         // Details decoded by DecodeDetails
+}
+
+// TransactionAgent represents the other party of a transaction.
+type TransactionAgent struct {
+	ID   int64  `json:"id"   validate:"required,min=1"` // Agent's unique identifier
+	Type string `json:"type" validate:"required"`       // Type of agent ("User" or "Group")
+	Name string `json:"name"`                           // Name of the agent
+}
+
+// TransactionCurrency represents the amount of a transaction.
+type TransactionCurrency struct {
+	Amount int64  `json:"amount"`                     // Amount of currency (negative for outgoing transactions)
+	Type   string `json:"type"   validate:"required"` // Type of currency (e.g., "Robux")
+}
+
+// TransactionDetails is implemented by the typed details payload of each transaction type.
+type TransactionDetails interface {
+	TransactionType() TransactionType
+}
+
+// TransactionItem represents the item a purchase or sale was made for.
+type TransactionItem struct {
+	ID    int64             `json:"id"`    // Unique identifier for the item
+	Name  string            `json:"name"`  // Name of the item
+	Type  string            `json:"type"`  // Type of the item (e.g., "Asset", "GamePass", "DeveloperProduct")
+	Place *TransactionPlace `json:"place"` // Place the transaction happened in (if any)
+}
+
+// TransactionPlace represents the place a transaction happened in.
+type TransactionPlace struct {
+	PlaceID    int64  `json:"placeId"`    // Unique identifier for the place
+	UniverseID int64  `json:"universeId"` // Unique identifier for the universe
+	Name       string `json:"name"`       // Name of the place
+}
+
+// PurchaseDetails represents the details of a Purchase transaction.
+type PurchaseDetails TransactionItem
+
+// SaleDetails represents the details of a Sale transaction.
+type SaleDetails TransactionItem
+
+// AffiliateSaleDetails represents the details of an AffiliateSale transaction.
+type AffiliateSaleDetails TransactionItem
+
+// TradeRobuxDetails represents the details of a TradeRobux transaction.
+type TradeRobuxDetails struct {
+	ID   int64  `json:"id"`   // Unique identifier for the trade
+	Type string `json:"type"` // Type of the record (e.g., "Trade")
+}
+
+// EngagementPayoutDetails represents the details of an EngagementPayout transaction.
+type EngagementPayoutDetails struct {
+	Place *TransactionPlace `json:"place"` // Place that earned the payout (if any)
+}
+
+// GroupEngagementPayoutDetails represents the details of a GroupEngagementPayout transaction.
+type GroupEngagementPayoutDetails EngagementPayoutDetails
+
+// GroupPayoutDetails represents the details of a GroupPayout transaction.
+// The paying group is given by the transaction agent.
+type GroupPayoutDetails struct{}
+
+// DevExDetails represents the details of a DevEx transaction.
+// The exchanged Robux are given by the transaction currency.
+type DevExDetails struct{}
+
+// CurrencyPurchaseDetails represents the details of a CurrencyPurchase transaction.
+// The bought Robux are given by the transaction currency.
+type CurrencyPurchaseDetails struct{}
+
+// PremiumStipendDetails represents the details of a PremiumStipend transaction.
+// The stipend is given by the transaction currency.
+type PremiumStipendDetails struct{}
+
+// AdSpendDetails represents the details of an AdSpend transaction.
+type AdSpendDetails TransactionItem
+
+// AdImpressionPayoutDetails represents the details of an AdImpressionPayout transaction.
+type AdImpressionPayoutDetails struct {
+	Place *TransactionPlace `json:"place"` // Place the ads were shown in (if any)
+}
+
+// PublishingAdvanceRebatesDetails represents the details of a PublishingAdvanceRebates transaction.
+type PublishingAdvanceRebatesDetails TransactionItem
+
+// AffiliatePayoutDetails represents the details of an AffiliatePayout transaction.
+type AffiliatePayoutDetails TransactionItem
+
+// UnknownTransactionDetails holds the details of a transaction type without a dedicated struct.
+type UnknownTransactionDetails struct {
+	Type TransactionType // Type of the transaction
+	Raw  json.RawMessage // Undecoded details payload
+}
+
+// TransactionType implements TransactionDetails.
+func (PurchaseDetails) TransactionType() TransactionType { return TransactionTypePurchase }
+
+// TransactionType implements TransactionDetails.
+func (SaleDetails) TransactionType() TransactionType { return TransactionTypeSale }
+
+// TransactionType implements TransactionDetails.
+func (AffiliateSaleDetails) TransactionType() TransactionType { return TransactionTypeAffiliateSale }
+
+// TransactionType implements TransactionDetails.
+func (TradeRobuxDetails) TransactionType() TransactionType { return TransactionTypeTradeRobux }
+
+// TransactionType implements TransactionDetails.
+func (EngagementPayoutDetails) TransactionType() TransactionType {
+	return TransactionTypeEngagementPayout
+}
+
+// TransactionType implements TransactionDetails.
+func (GroupEngagementPayoutDetails) TransactionType() TransactionType {
+	return TransactionTypeGroupEngagementPayout
+}
+
+// TransactionType implements TransactionDetails.
+func (GroupPayoutDetails) TransactionType() TransactionType { return TransactionTypeGroupPayout }
+
+// TransactionType implements TransactionDetails.
+func (DevExDetails) TransactionType() TransactionType { return TransactionTypeDevEx }
+
+// TransactionType implements TransactionDetails.
+func (CurrencyPurchaseDetails) TransactionType() TransactionType {
+	return TransactionTypeCurrencyPurchase
+}
+
+// TransactionType implements TransactionDetails.
+func (PremiumStipendDetails) TransactionType() TransactionType { return TransactionTypePremiumStipend }
+
+// TransactionType implements TransactionDetails.
+func (AdSpendDetails) TransactionType() TransactionType { return TransactionTypeAdSpend }
+
+// TransactionType implements TransactionDetails.
+func (AdImpressionPayoutDetails) TransactionType() TransactionType {
+	return TransactionTypeAdImpressionPayout
+}
+
+// TransactionType implements TransactionDetails.
+func (PublishingAdvanceRebatesDetails) TransactionType() TransactionType {
+	return TransactionTypePublishingAdvanceRebates
+}
+
+// TransactionType implements TransactionDetails.
+func (AffiliatePayoutDetails) TransactionType() TransactionType {
+	return TransactionTypeAffiliatePayout
+}
+
+// TransactionType implements TransactionDetails.
+func (d UnknownTransactionDetails) TransactionType() TransactionType { return d.Type }
+
+// DecodeDetails decodes RawDetails into the struct matching the transaction type.
+// The type reported by the transaction takes precedence over the given fallback.
+func (t *Transaction) DecodeDetails(fallback TransactionType) error {
+	txType := t.TransactionType
+	if txType == "" {
+		txType = fallback
+	}
+
+	var details TransactionDetails
+
+	switch txType {
+	case TransactionTypePurchase:
+		details = &PurchaseDetails{}
+	case TransactionTypeSale:
+		details = &SaleDetails{}
+	case TransactionTypeAffiliateSale:
+		details = &AffiliateSaleDetails{}
+	case TransactionTypeTradeRobux:
+		details = &TradeRobuxDetails{}
+	case TransactionTypeEngagementPayout:
+		details = &EngagementPayoutDetails{}
+	case TransactionTypeGroupEngagementPayout:
+		details = &GroupEngagementPayoutDetails{}
+	case TransactionTypeGroupPayout:
+		details = &GroupPayoutDetails{}
+	case TransactionTypeDevEx:
+		details = &DevExDetails{}
+	case TransactionTypeCurrencyPurchase:
+		details = &CurrencyPurchaseDetails{}
+	case TransactionTypePremiumStipend:
+		details = &PremiumStipendDetails{}
+	case TransactionTypeAdSpend:
+		details = &AdSpendDetails{}
+	case TransactionTypeAdImpressionPayout:
+		details = &AdImpressionPayoutDetails{}
+	case TransactionTypePublishingAdvanceRebates:
+		details = &PublishingAdvanceRebatesDetails{}
+	case TransactionTypeAffiliatePayout:
+		details = &AffiliatePayoutDetails{}
+	default:
+		t.Details = &UnknownTransactionDetails{Type: txType, Raw: t.RawDetails}
+		return nil
+	}
+
+	if len(t.RawDetails) > 0 && string(t.RawDetails) != "null" {
+		if err := json.Unmarshal(t.RawDetails, details); err != nil {
+			return fmt.Errorf("failed to decode %s details: %w", txType, err)
+		}
+	}
+
+	t.Details = details
+
+	return nil
+}
+
+// TransactionTotalsResponse represents the transaction totals of a user over a time frame.
+type TransactionTotalsResponse struct {
+	SalesTotal               int64 `json:"salesTotal"`               // Robux earned from sales
+	PurchasesTotal           int64 `json:"purchasesTotal"`           // Robux spent on purchases
+	AffiliateSalesTotal      int64 `json:"affiliateSalesTotal"`      // Robux earned from affiliate sales
+	GroupPayoutsTotal        int64 `json:"groupPayoutsTotal"`        // Robux received from group payouts
+	CurrencyPurchasesTotal   int64 `json:"currencyPurchasesTotal"`   // Robux bought with real currency
+	PremiumStipendsTotal     int64 `json:"premiumStipendsTotal"`     // Robux received from Premium stipends
+	TradeSystemEarningsTotal int64 `json:"tradeSystemEarningsTotal"` // Robux received from trades
+	TradeSystemCostsTotal    int64 `json:"tradeSystemCostsTotal"`    // Robux spent on trades
+	PremiumPayoutsTotal      int64 `json:"premiumPayoutsTotal"`      // Robux earned from Premium payouts
+	GroupPremiumPayoutsTotal int64 `json:"groupPremiumPayoutsTotal"` // Robux earned from group Premium payouts
+	AdSpendTotal             int64 `json:"adSpendTotal"`             // Robux spent on ads
+	DeveloperExchangeTotal   int64 `json:"developerExchangeTotal"`   // Robux cashed out through DevEx
+	PendingRobuxTotal        int64 `json:"pendingRobuxTotal"`        // Robux still pending
+	IncomingRobuxTotal       int64 `json:"incomingRobuxTotal"`       // Total incoming Robux
+	OutgoingRobuxTotal       int64 `json:"outgoingRobuxTotal"`       // Total outgoing Robux
+	IndividualToGroupTotal   int64 `json:"individualToGroupTotal"`   // Robux sent from the user to groups
+	CsAdjustmentTotal        int64 `json:"csAdjustmentTotal"`        // Robux adjusted by customer support
+	AffiliatePayoutTotal     int64 `json:"affiliatePayoutTotal"`     // Robux earned from affiliate payouts
+}
+
+// GroupRevenueSummaryResponse represents the revenue summary of a group over a time frame.
+type GroupRevenueSummaryResponse struct {
+	RecurringRobuxStipend  int64 `json:"recurringRobuxStipend"`  // Robux received from recurring stipends
+	ItemSaleRobux          int64 `json:"itemSaleRobux"`          // Robux earned from item sales
+	PurchasedRobux         int64 `json:"purchasedRobux"`         // Robux bought with real currency
+	TradeSystemRobux       int64 `json:"tradeSystemRobux"`       // Robux received from trades
+	PendingRobux           int64 `json:"pendingRobux"`           // Robux still pending
+	GroupPayoutRobux       int64 `json:"groupPayoutRobux"`       // Robux paid out to members
+	IndividualToGroupRobux int64 `json:"individualToGroupRobux"` // Robux received from individuals
+	PremiumPayouts         int64 `json:"premiumPayouts"`         // Robux earned from Premium payouts
+	GroupPremiumPayouts    int64 `json:"groupPremiumPayouts"`    // Robux earned from group Premium payouts
+	AdjustmentRobux        int64 `json:"adjustmentRobux"`        // Robux adjusted by customer support
+}
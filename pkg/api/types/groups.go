@@ -160,3 +160,107 @@ type GroupWallPoster struct {
 	User GroupUser `json:"user" validate:"required"` // User information
 	Role GroupRole `json:"role" validate:"required"` // User's role in the group
 }
+
+// GroupRelationshipType represents the type of relationship between two groups.
+type GroupRelationshipType string
+
+const (
+	GroupRelationshipAllies  GroupRelationshipType = "Allies"
+	GroupRelationshipEnemies GroupRelationshipType = "Enemies"
+)
+
+// GroupRelationshipsResponse represents the structure of group relationships returned by the Roblox API.
+type GroupRelationshipsResponse struct {
+	GroupID          int64                 `json:"groupId"          validate:"required,min=1"`                // Unique identifier for the group
+	RelationshipType GroupRelationshipType `json:"relationshipType" validate:"required,oneof=Allies Enemies"` // Type of the relationships
+	TotalGroupCount  int64                 `json:"totalGroupCount"  validate:"min=0"`                         // Total number of related groups
+	RelatedGroups    []GroupResponse       `json:"relatedGroups"    validate:"required,dive"`                 // List of related groups
+	NextRowIndex     int64                 `json:"nextRowIndex"     validate:"min=0"`                         // Row index to start the next page from
+}
+
+// GroupNameHistoryResponse represents the structure of a group's name history returned by the Roblox API.
+type GroupNameHistoryResponse struct {
+	PreviousPageCursor *string            `json:"previousPageCursor" validate:"omitempty"`     // Cursor for the previous page of results (if any)
+	NextPageCursor     *string            `json:"nextPageCursor"     validate:"omitempty"`     // Cursor for the next page of results (if any)
+	Data               []GroupNameHistory `json:"data"               validate:"required,dive"` // List of previous group names
+}
+
+// GroupNameHistory represents a single previous name of a group.
+type GroupNameHistory struct {
+	Name    string    `json:"name"    validate:"required,min=1"` // A previous name of the group
+	Created time.Time `json:"created" validate:"required"`       // When the group was renamed to this name
+}
+
+// GroupMembershipResponse represents the authenticated user's membership metadata for a group.
+type GroupMembershipResponse struct {
+	GroupID              int64               `json:"groupId"              validate:"required,min=1"` // Unique identifier for the group
+	IsPrimary            bool                `json:"isPrimary"`                                      // Whether the group is the user's primary group
+	IsPendingJoin        bool                `json:"isPendingJoin"`                                  // Whether the user has a pending join request
+	UserRole             GroupMembershipRole `json:"userRole"             validate:"required"`       // The user's role in the group
+	Permissions          GroupPermissions    `json:"permissions"`                                    // The user's permissions in the group
+	AreGroupGamesVisible bool                `json:"areGroupGamesVisible"`                           // Whether the group's games are visible
+	AreGroupFundsVisible bool                `json:"areGroupFundsVisible"`                           // Whether the group's funds are visible
+	AreEnemiesAllowed    bool                `json:"areEnemiesAllowed"`                              // Whether the group allows enemies
+	CanConfigure         bool                `json:"canConfigure"`                                   // Whether the user can configure the group
+}
+
+// GroupMembershipRole represents the user and role of a group membership.
+type GroupMembershipRole struct {
+	User *GroupUser    `json:"user" validate:"omitempty"` // User information (absent for guests)
+	Role UserGroupRole `json:"role" validate:"required"`  // Role of the user in the group
+}
+
+// GroupPermissions represents the permissions a role has in a group.
+type GroupPermissions struct {
+	GroupPostsPermissions      GroupPostsPermissions      `json:"groupPostsPermissions"`      // Wall and status permissions
+	GroupMembershipPermissions GroupMembershipPermissions `json:"groupMembershipPermissions"` // Membership permissions
+	GroupManagementPermissions GroupManagementPermissions `json:"groupManagementPermissions"` // Management permissions
+	GroupEconomyPermissions    GroupEconomyPermissions    `json:"groupEconomyPermissions"`    // Economy permissions
+}
+
+// GroupPostsPermissions represents the wall and status permissions of a role.
+type GroupPostsPermissions struct {
+	ViewWall       bool `json:"viewWall"`       // Whether the role can view the wall
+	PostToWall     bool `json:"postToWall"`     // Whether the role can post to the wall
+	DeleteFromWall bool `json:"deleteFromWall"` // Whether the role can delete wall posts
+	ViewStatus     bool `json:"viewStatus"`     // Whether the role can view the shout
+	PostToStatus   bool `json:"postToStatus"`   // Whether the role can post a shout
+}
+
+// GroupMembershipPermissions represents the membership permissions of a role.
+type GroupMembershipPermissions struct {
+	ChangeRank    bool `json:"changeRank"`    // Whether the role can change member ranks
+	InviteMembers bool `json:"inviteMembers"` // Whether the role can accept join requests
+	RemoveMembers bool `json:"removeMembers"` // Whether the role can remove members
+	BanMembers    bool `json:"banMembers"`    // Whether the role can ban members
+}
+
+// GroupManagementPermissions represents the management permissions of a role.
+type GroupManagementPermissions struct {
+	ManageRelationships bool `json:"manageRelationships"` // Whether the role can manage allies and enemies
+	ManageClan          bool `json:"manageClan"`          // Whether the role can manage the clan
+	ViewAuditLogs       bool `json:"viewAuditLogs"`       // Whether the role can view audit logs
+}
+
+// GroupEconomyPermissions represents the economy permissions of a role.
+type GroupEconomyPermissions struct {
+	SpendGroupFunds  bool `json:"spendGroupFunds"`  // Whether the role can spend group funds
+	AdvertiseGroup   bool `json:"advertiseGroup"`   // Whether the role can advertise the group
+	CreateItems      bool `json:"createItems"`      // Whether the role can create items
+	ManageItems      bool `json:"manageItems"`      // Whether the role can manage items
+	AddGroupPlaces   bool `json:"addGroupPlaces"`   // Whether the role can add group places
+	ManageGroupGames bool `json:"manageGroupGames"` // Whether the role can manage group games
+	ViewGroupPayouts bool `json:"viewGroupPayouts"` // Whether the role can view group payouts
+	ViewAnalytics    bool `json:"viewAnalytics"`    // Whether the role can view analytics
+}
+
+// GroupPoliciesResponse represents the structure of group policies returned by the Roblox API.
+type GroupPoliciesResponse struct {
+	Groups []GroupPolicy `json:"groups" validate:"required,dive"` // List of group policies
+}
+
+// GroupPolicy represents the policy information for a single group.
+type GroupPolicy struct {
+	GroupID      int64 `json:"groupId"      validate:"required,min=1"` // Unique identifier for the group
+	CanViewGroup bool  `json:"canViewGroup"`                           // Whether the group can be viewed
+}
@@ -11,10 +11,11 @@ type InventoryAssetResponse struct {
 
 // InventoryAsset represents a single asset in a user's inventory.
 type InventoryAsset struct {
-	AssetID   int64     `json:"assetId"   validate:"required,min=1"` // Unique identifier for the asset
-	Name      string    `json:"name"      validate:"required,min=1"` // Name of the asset
-	AssetType string    `json:"assetType" validate:"required,min=1"` // Type of the asset (e.g., "Hat", "Shirt")
-	Created   time.Time `json:"created"   validate:"required"`       // When the asset was created/acquired
+	UserAssetID int64     `json:"userAssetId"`                           // Unique identifier for the user's copy of the asset (if provided)
+	AssetID     int64     `json:"assetId"     validate:"required,min=1"` // Unique identifier for the asset
+	Name        string    `json:"name"        validate:"required,min=1"` // Name of the asset
+	AssetType   string    `json:"assetType"   validate:"required,min=1"` // Type of the asset (e.g., "Hat", "Shirt")
+	Created     time.Time `json:"created"     validate:"required"`       // When the asset was created/acquired
 }
 
 // ItemAssetType represents the type of asset in the inventory.
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SortedMapItemsResponse represents a page of memory store sorted map items.
+type SortedMapItemsResponse struct {
+	Items         []SortedMapItem `json:"memoryStoreSortedMapItems" validate:"dive"` // List of items on the page
+	NextPageToken string          `json:"nextPageToken"`                             // Token for the next page, empty on the last page
+}
+
+// SortedMapItem represents an item of a memory store sorted map.
+// Items are ordered by sort key first and ID second; at most one sort key is set.
+type SortedMapItem struct {
+	Path           string          `json:"path"           validate:"required"` // Resource path of the item
+	ID             string          `json:"id"`                                 // Key of the item
+	Value          json.RawMessage `json:"value"`                              // JSON value of the item
+	Etag           string          `json:"etag"`                               // Checksum of the item, for optimistic concurrency
+	ExpireTime     time.Time       `json:"expireTime"`                         // When the item expires
+	StringSortKey  *string         `json:"stringSortKey"`                      // String sort key, if the item has one
+	NumericSortKey *float64        `json:"numericSortKey"`                     // Numeric sort key, if the item has one
+}
+
+// QueueItem represents an item of a memory store queue.
+type QueueItem struct {
+	Path       string          `json:"path"       validate:"required"` // Resource path of the item
+	Data       json.RawMessage `json:"data"`                           // JSON data of the item
+	Priority   float64         `json:"priority"`                       // Priority of the item; higher priorities are read first
+	ExpireTime time.Time       `json:"expireTime"`                     // When the item expires
+}
+
+// QueueReadResponse represents items read from a memory store queue.
+// The items stay invisible to other readers until the invisibility window ends, and are
+// only removed from the queue once discarded with the read ID.
+type QueueReadResponse struct {
+	ReadID string            `json:"id"    validate:"required_with=Items"` // ID of the read, used to discard the items
+	Data   []json.RawMessage `json:"data"`                                 // JSON data of the items read
+	Items  []QueueItem       `json:"items"`                                // Items read, including their metadata
+}
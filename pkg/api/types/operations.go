@@ -0,0 +1,20 @@
+package types
+
+import "encoding/json"
+
+// Operation represents a long-running Open Cloud operation, whose response is of type T
+// once it is done.
+type Operation[T any] struct {
+	Path     string           `json:"path"     validate:"required"` // Resource path of the operation (e.g., "operations/abc")
+	Done     bool             `json:"done"`                         // Whether the operation finished, successfully or not
+	Error    *OperationStatus `json:"error"`                        // Why the operation failed, if it did
+	Response *T               `json:"response"`                     // Result of the operation, once it succeeded
+	Metadata json.RawMessage  `json:"metadata"`                     // Progress information of the operation, if any
+}
+
+// OperationStatus represents the error of a failed operation.
+type OperationStatus struct {
+	Code    int               `json:"code"`    // gRPC status code of the error
+	Message string            `json:"message"` // Developer-facing description of the error
+	Details []json.RawMessage `json:"details"` // Additional error details, each with an "@type" field
+}
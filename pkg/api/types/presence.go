@@ -28,3 +28,14 @@ type UserPresenceResponse struct {
 	UserID           int64            `json:"userId"           validate:"required,min=1"`  // ID of the user
 	LastOnline       *time.Time       `json:"lastOnline"       validate:"omitempty"`       // Last time the user was online
 }
+
+// LastOnlineResponse represents the last online timestamps of users returned by the Roblox API.
+type LastOnlineResponse struct {
+	LastOnlineTimestamps []LastOnline `json:"lastOnlineTimestamps" validate:"required,dive"` // List of last online timestamps
+}
+
+// LastOnline represents the last time a single user was online.
+type LastOnline struct {
+	UserID     int64     `json:"userId"     validate:"required,min=1"` // ID of the user
+	LastOnline time.Time `json:"lastOnline"`                           // Last time the user was online
+}
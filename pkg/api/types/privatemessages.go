@@ -0,0 +1,57 @@
+package types
+
+import "time"
+
+// MessageTab represents the folder of private messages to list.
+type MessageTab string
+
+const (
+	MessageTabInbox   MessageTab = "Inbox"
+	MessageTabSent    MessageTab = "Sent"
+	MessageTabArchive MessageTab = "Archive"
+)
+
+// MessagesPageResponse represents the structure of a page of private messages returned by the Roblox API.
+type MessagesPageResponse struct {
+	Collection          []Message `json:"collection"          validate:"dive"`  // List of messages on the page
+	TotalCollectionSize int64     `json:"totalCollectionSize" validate:"min=0"` // Total number of messages in the folder
+	TotalPages          int64     `json:"totalPages"          validate:"min=0"` // Total number of pages
+	PageNumber          int64     `json:"pageNumber"          validate:"min=0"` // Zero-based index of the page
+}
+
+// Message represents a single private message.
+type Message struct {
+	ID                     int64             `json:"id"                     validate:"required,min=1"` // Unique identifier for the message
+	Sender                 VerifiedBadgeUser `json:"sender"                 validate:"required"`       // User who sent the message
+	Recipient              VerifiedBadgeUser `json:"recipient"              validate:"required"`       // User who received the message
+	Subject                string            `json:"subject"`                                          // Subject of the message
+	Body                   string            `json:"body"`                                             // Body of the message
+	Created                time.Time         `json:"created"                validate:"required"`       // When the message was sent
+	Updated                time.Time         `json:"updated"`                                          // When the message was last updated
+	IsRead                 bool              `json:"isRead"`                                           // Whether the message has been read
+	IsSystemMessage        bool              `json:"isSystemMessage"`                                  // Whether the message was sent by Roblox
+	IsReportAbuseDisplayed bool              `json:"isReportAbuseDisplayed"`                           // Whether the message can be reported
+}
+
+// UnreadMessagesCountResponse represents the number of unread private messages.
+type UnreadMessagesCountResponse struct {
+	Count int64 `json:"count" validate:"min=0"` // Number of unread messages
+}
+
+// SendMessageResponse represents the result of sending a private message.
+type SendMessageResponse struct {
+	Success      bool   `json:"success"`      // Whether the message was sent
+	ShortMessage string `json:"shortMessage"` // Short status message
+	Message      string `json:"message"`      // Detailed status message
+}
+
+// MessageBatchResponse represents the result of updating a batch of private messages.
+type MessageBatchResponse struct {
+	FailedMessages []FailedMessage `json:"failedMessages" validate:"dive"` // Messages that could not be updated
+}
+
+// FailedMessage represents a message that could not be updated in a batch.
+type FailedMessage struct {
+	MessageID    int64  `json:"messageId"    validate:"required,min=1"` // Unique identifier for the message
+	ErrorMessage string `json:"errorMessage"`                           // Reason the update failed
+}
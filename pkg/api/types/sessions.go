@@ -0,0 +1,46 @@
+package types
+
+import (
+	"strconv"
+	"time"
+)
+
+// SessionsResponse represents a page of the authenticated user's active sessions.
+type SessionsResponse struct {
+	Sessions   []Session `json:"sessions"   validate:"dive"` // List of sessions on the page
+	NextCursor string    `json:"nextCursor"`                 // Cursor for the next page, empty on the last page
+}
+
+// Session represents a single active login session.
+type Session struct {
+	Token                 string          `json:"token"                                  validate:"required"` // Token identifying the session, used to revoke it
+	Location              SessionLocation `json:"location"`                                                   // Approximate location of the session
+	Agent                 SessionAgent    `json:"agent"`                                                      // Client the session was created from
+	LastAccessedIP        string          `json:"lastAccessedIp"`                                             // IP address the session was last used from
+	LastAccessedTimestamp string          `json:"lastAccessedTimestampEpochMilliseconds"`                     // When the session was last used, in Unix milliseconds
+	IsCurrentSession      bool            `json:"isCurrentSession"`                                           // Whether this is the session of the cookie used for the request
+}
+
+// LastAccessed returns when the session was last used, or the zero time if it is unknown.
+func (s *Session) LastAccessed() time.Time {
+	millis, err := strconv.ParseInt(s.LastAccessedTimestamp, 10, 64)
+	if err != nil || millis <= 0 {
+		return time.Time{}
+	}
+
+	return time.UnixMilli(millis)
+}
+
+// SessionLocation represents the approximate location of a session.
+type SessionLocation struct {
+	City        string `json:"city"`        // City name
+	Subdivision string `json:"subdivision"` // State or region name
+	Country     string `json:"country"`     // Country name
+}
+
+// SessionAgent represents the client a session was created from.
+type SessionAgent struct {
+	Type  string `json:"type"`  // Kind of client (e.g., "Browser", "App")
+	Value string `json:"value"` // Name of the client (e.g., "Chrome")
+	OS    string `json:"os"`    // Operating system of the client
+}
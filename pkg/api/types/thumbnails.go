@@ -37,18 +37,38 @@ type ThumbnailSize string
 
 const (
 	Size30x30   ThumbnailSize = "30x30"
+	Size42x42   ThumbnailSize = "42x42"
 	Size48x48   ThumbnailSize = "48x48"
+	Size50x50   ThumbnailSize = "50x50"
 	Size60x60   ThumbnailSize = "60x60"
+	Size60x62   ThumbnailSize = "60x62"
 	Size75x75   ThumbnailSize = "75x75"
 	Size100x100 ThumbnailSize = "100x100"
 	Size110x110 ThumbnailSize = "110x110"
+	Size128x128 ThumbnailSize = "128x128"
 	Size140x140 ThumbnailSize = "140x140"
 	Size150x150 ThumbnailSize = "150x150"
+	Size150x200 ThumbnailSize = "150x200"
+	Size160x100 ThumbnailSize = "160x100"
+	Size160x600 ThumbnailSize = "160x600"
 	Size180x180 ThumbnailSize = "180x180"
 	Size250x250 ThumbnailSize = "250x250"
+	Size256x144 ThumbnailSize = "256x144"
+	Size256x256 ThumbnailSize = "256x256"
+	Size300x250 ThumbnailSize = "300x250"
+	Size304x166 ThumbnailSize = "304x166"
 	Size352x352 ThumbnailSize = "352x352"
+	Size384x216 ThumbnailSize = "384x216"
+	Size396x216 ThumbnailSize = "396x216"
 	Size420x420 ThumbnailSize = "420x420"
+	Size480x270 ThumbnailSize = "480x270"
+	Size512x512 ThumbnailSize = "512x512"
+	Size576x324 ThumbnailSize = "576x324"
+	Size700x700 ThumbnailSize = "700x700"
 	Size720x720 ThumbnailSize = "720x720"
+	Size728x90  ThumbnailSize = "728x90"
+	Size768x432 ThumbnailSize = "768x432"
+	Size1200x80 ThumbnailSize = "1200x80"
 )
 
 // ThumbnailState represents the state of the thumbnail.
@@ -90,3 +110,68 @@ type ThumbnailData struct {
 	ImageURL     *string        `json:"imageUrl"     validate:"omitempty"`                                                                      // URL of the thumbnail image
 	Version      *string        `json:"version"      validate:"omitempty"`                                                                      // Version of the thumbnail
 }
+
+// Thumbnail3DResponse represents the state of a 3D thumbnail returned by the Roblox API.
+type Thumbnail3DResponse struct {
+	TargetID int64          `json:"targetId"`                                                                                           // ID of the target user or asset
+	State    ThumbnailState `json:"state"    validate:"required,oneof=Error Completed InReview Pending Blocked TemporarilyUnavailable"` // Current state of the thumbnail
+	ImageURL *string        `json:"imageUrl" validate:"omitempty,url"`                                                                  // URL of the JSON manifest (once completed)
+	Version  *string        `json:"version"  validate:"omitempty"`                                                                      // Version of the thumbnail
+}
+
+// Thumbnail3DManifest represents the JSON manifest describing a 3D thumbnail.
+// Files are referenced by their CDN content hash, which is validated as hexadecimal
+// because it is used as a file name and URL path.
+type Thumbnail3DManifest struct {
+	Camera   Thumbnail3DCamera `json:"camera"`                                        // Suggested camera placement
+	AABB     Thumbnail3DAABB   `json:"aabb"`                                          // Axis-aligned bounding box of the model
+	MTL      string            `json:"mtl"      validate:"required,hexadecimal"`      // Content hash of the MTL file
+	OBJ      string            `json:"obj"      validate:"required,hexadecimal"`      // Content hash of the OBJ file
+	Textures []string          `json:"textures" validate:"dive,required,hexadecimal"` // Content hashes of the texture files
+}
+
+// Thumbnail3DCamera represents the suggested camera placement for a 3D thumbnail.
+type Thumbnail3DCamera struct {
+	Position  Thumbnail3DVector `json:"position"`  // Camera position
+	Direction Thumbnail3DVector `json:"direction"` // Camera direction
+	FOV       float64           `json:"fov"`       // Field of view in degrees
+}
+
+// Thumbnail3DAABB represents the axis-aligned bounding box of a 3D thumbnail.
+type Thumbnail3DAABB struct {
+	Min Thumbnail3DVector `json:"min"` // Minimum corner
+	Max Thumbnail3DVector `json:"max"` // Maximum corner
+}
+
+// Thumbnail3DVector represents a 3D vector in a thumbnail manifest.
+type Thumbnail3DVector struct {
+	X float64 `json:"x"` // X coordinate
+	Y float64 `json:"y"` // Y coordinate
+	Z float64 `json:"z"` // Z coordinate
+}
+
+// GameThumbnailsResponse represents the thumbnails of multiple games returned by the Roblox API.
+type GameThumbnailsResponse struct {
+	Data []GameThumbnails `json:"data" validate:"required,dive"` // List of thumbnails per game
+}
+
+// GameThumbnails represents the thumbnails of a single game.
+type GameThumbnails struct {
+	UniverseID int64               `json:"universeId" validate:"required,min=1"` // Universe ID of the game
+	Error      *GameThumbnailError `json:"error"`                                // Error fetching the thumbnails (if any)
+	Thumbnails []GameThumbnail     `json:"thumbnails" validate:"dive"`           // Thumbnails of the game
+}
+
+// GameThumbnailError represents an error fetching the thumbnails of a game.
+type GameThumbnailError struct {
+	Code    int    `json:"code"`    // Error code
+	Message string `json:"message"` // Error message
+}
+
+// GameThumbnail represents a single thumbnail of a game.
+type GameThumbnail struct {
+	TargetID int64          `json:"targetId"`                                                                                           // ID of the thumbnail image
+	State    ThumbnailState `json:"state"    validate:"required,oneof=Error Completed InReview Pending Blocked TemporarilyUnavailable"` // Current state of the thumbnail
+	ImageURL *string        `json:"imageUrl" validate:"omitempty"`                                                                      // URL of the thumbnail image
+	Version  *string        `json:"version"  validate:"omitempty"`                                                                      // Version of the thumbnail
+}
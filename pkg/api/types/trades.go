@@ -0,0 +1,78 @@
+package types
+
+import "time"
+
+// TradeStatusType represents the category of trades to list.
+type TradeStatusType string
+
+const (
+	TradeStatusTypeInbound   TradeStatusType = "Inbound"
+	TradeStatusTypeOutbound  TradeStatusType = "Outbound"
+	TradeStatusTypeCompleted TradeStatusType = "Completed"
+	TradeStatusTypeInactive  TradeStatusType = "Inactive"
+)
+
+// TradesResponse represents the structure of a paginated trade list returned by the Roblox API.
+type TradesResponse struct {
+	PreviousPageCursor *string        `json:"previousPageCursor" validate:"omitempty"`     // Cursor for the previous page of results (if any)
+	NextPageCursor     *string        `json:"nextPageCursor"     validate:"omitempty"`     // Cursor for the next page of results (if any)
+	Data               []TradeSummary `json:"data"               validate:"required,dive"` // List of trades
+}
+
+// TradeSummary represents a single trade in a trade list.
+type TradeSummary struct {
+	ID         int64     `json:"id"         validate:"required,min=1"` // Unique identifier for the trade
+	User       TradeUser `json:"user"       validate:"required"`       // Trade partner
+	Created    time.Time `json:"created"    validate:"required"`       // When the trade was created
+	Expiration time.Time `json:"expiration"`                           // When the trade expires
+	IsActive   bool      `json:"isActive"`                             // Whether the trade is still active
+	Status     string    `json:"status"     validate:"required"`       // Status of the trade (e.g., "Open", "Completed")
+}
+
+// TradeResponse represents the detailed information about a specific trade.
+type TradeResponse struct {
+	ID         int64        `json:"id"         validate:"required,min=1"`      // Unique identifier for the trade
+	User       TradeUser    `json:"user"       validate:"required"`            // Trade partner
+	Offers     []TradeOffer `json:"offers"     validate:"required,len=2,dive"` // Offers from both sides of the trade
+	Created    time.Time    `json:"created"    validate:"required"`            // When the trade was created
+	Expiration time.Time    `json:"expiration"`                                // When the trade expires
+	IsActive   bool         `json:"isActive"`                                  // Whether the trade is still active
+	Status     string       `json:"status"     validate:"required"`            // Status of the trade
+}
+
+// TradeUser represents a user taking part in a trade.
+type TradeUser struct {
+	ID          int64  `json:"id"          validate:"required,min=1"` // Unique identifier for the user
+	Name        string `json:"name"        validate:"required,min=1"` // Username of the user
+	DisplayName string `json:"displayName" validate:"required,min=1"` // Display name of the user
+}
+
+// TradeOffer represents one side of a trade.
+type TradeOffer struct {
+	User       TradeUser        `json:"user"       validate:"required"` // User making the offer
+	UserAssets []TradeUserAsset `json:"userAssets" validate:"dive"`     // Assets offered
+	Robux      int64            `json:"robux"      validate:"min=0"`    // Robux offered
+}
+
+// TradeUserAsset represents a single copy of an asset offered in a trade.
+type TradeUserAsset struct {
+	ID                 int64  `json:"id"                 validate:"required,min=1"` // Unique identifier for the user's copy of the asset
+	SerialNumber       *int64 `json:"serialNumber"`                                 // Serial number of the copy (if any)
+	AssetID            int64  `json:"assetId"            validate:"required,min=1"` // Unique identifier for the asset
+	Name               string `json:"name"               validate:"required"`       // Name of the asset
+	RecentAveragePrice int64  `json:"recentAveragePrice" validate:"min=0"`          // Recent average price in Robux
+	OriginalPrice      *int64 `json:"originalPrice"`                                // Original price in Robux
+	AssetStock         *int64 `json:"assetStock"`                                   // Total number of copies (if limited)
+	MembershipType     string `json:"membershipType"`                               // Membership required to own the asset
+}
+
+// CanTradeWithResponse represents whether the authenticated user can trade with another user.
+type CanTradeWithResponse struct {
+	CanTrade bool   `json:"canTrade"`                     // Whether a trade is possible
+	Status   string `json:"status"   validate:"required"` // Reason code (e.g., "CanTrade", "SenderCannotTrade")
+}
+
+// TradeIDResponse represents the identifier of a newly created trade.
+type TradeIDResponse struct {
+	ID int64 `json:"id" validate:"required,min=1"` // Unique identifier for the trade
+}
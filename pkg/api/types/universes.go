@@ -0,0 +1,7 @@
+package types
+
+// UserNotification represents an experience notification sent to a user.
+type UserNotification struct {
+	Path string `json:"path" validate:"required"` // Resource path of the notification
+	ID   string `json:"id"   validate:"required"` // ID of the notification
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UserRestrictionsResponse represents a page of user restrictions returned by the Open Cloud API.
+type UserRestrictionsResponse struct {
+	UserRestrictions []UserRestriction `json:"userRestrictions" validate:"dive"` // List of restrictions on the page
+	NextPageToken    string            `json:"nextPageToken"`                    // Token for the next page, empty on the last page
+}
+
+// UserRestriction represents the restrictions of a user in a universe or one of its places.
+type UserRestriction struct {
+	Path                string              `json:"path"                validate:"required"` // Resource path of the restriction
+	UpdateTime          time.Time           `json:"updateTime"`                              // When the restriction was last changed
+	User                string              `json:"user"`                                    // Path of the restricted user (e.g., "users/123")
+	GameJoinRestriction GameJoinRestriction `json:"gameJoinRestriction"`                     // Whether and how the user is banned from joining
+}
+
+// UserID returns the ID of the restricted user, or 0 if the path is invalid.
+func (r *UserRestriction) UserID() int64 {
+	return parseUserPath(r.User)
+}
+
+// GameJoinRestriction represents a ban from joining a universe or place.
+type GameJoinRestriction struct {
+	Active             bool          `json:"active"`                  // Whether the ban is in effect
+	StartTime          time.Time     `json:"startTime,omitzero"`      // When the ban started
+	Duration           time.Duration `json:"duration"`                // How long the ban lasts, or 0 if it is permanent
+	PrivateReason      string        `json:"privateReason,omitempty"` // Reason only shown to the experience's moderators
+	DisplayReason      string        `json:"displayReason,omitempty"` // Reason shown to the banned user
+	ExcludeAltAccounts bool          `json:"excludeAltAccounts"`      // Whether the ban does not extend to the user's alternate accounts
+	Inherited          bool          `json:"inherited,omitempty"`     // Whether the ban of a place comes from its universe (read-only)
+}
+
+// EndTime returns when the ban ends, or the zero time if it is permanent or has no start time.
+func (g *GameJoinRestriction) EndTime() time.Time {
+	if g.Duration == 0 || g.StartTime.IsZero() {
+		return time.Time{}
+	}
+
+	return g.StartTime.Add(g.Duration)
+}
+
+// MarshalJSON encodes the duration the way Open Cloud does (e.g., "3600s").
+// Unset fields are left out, so that the restriction can be used as an update body.
+func (g GameJoinRestriction) MarshalJSON() ([]byte, error) {
+	type alias GameJoinRestriction
+
+	return json.Marshal(struct {
+		alias
+
+		Duration string `json:"duration,omitempty"`
+	}{
+		alias:    alias(g),
+		Duration: FormatCloudDuration(g.Duration),
+	})
+}
+
+// UnmarshalJSON decodes the duration from the way Open Cloud encodes it (e.g., "3600s").
+func (g *GameJoinRestriction) UnmarshalJSON(data []byte) error {
+	type alias GameJoinRestriction
+
+	var raw struct {
+		*alias
+
+		Duration string `json:"duration"`
+	}
+
+	raw.alias = (*alias)(g)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	duration, err := ParseCloudDuration(raw.Duration)
+	if err != nil {
+		return err
+	}
+
+	g.Duration = duration
+
+	return nil
+}
+
+// UserRestrictionLogsResponse represents a page of user restriction logs returned by the Open Cloud API.
+type UserRestrictionLogsResponse struct {
+	Logs          []UserRestrictionLog `json:"logs"`          // List of logs on the page, newest first
+	NextPageToken string               `json:"nextPageToken"` // Token for the next page, empty on the last page
+}
+
+// UserRestrictionLog represents a change to the restrictions of a user.
+type UserRestrictionLog struct {
+	User               string                   `json:"user"`               // Path of the restricted user (e.g., "users/123")
+	Place              string                   `json:"place"`              // Path of the place (e.g., "places/456"), empty for universe restrictions
+	Moderator          UserRestrictionModerator `json:"moderator"`          // Who changed the restriction
+	CreateTime         time.Time                `json:"createTime"`         // When the change was made
+	Active             bool                     `json:"active"`             // Whether the restriction was put in effect or lifted
+	StartTime          time.Time                `json:"startTime"`          // When the restriction started
+	Duration           time.Duration            `json:"duration"`           // How long the restriction lasts, or 0 if it is permanent
+	PrivateReason      string                   `json:"privateReason"`      // Reason only shown to the experience's moderators
+	DisplayReason      string                   `json:"displayReason"`      // Reason shown to the restricted user
+	ExcludeAltAccounts bool                     `json:"excludeAltAccounts"` // Whether the restriction does not extend to the user's alternate accounts
+}
+
+// UserID returns the ID of the restricted user, or 0 if the path is invalid.
+func (l *UserRestrictionLog) UserID() int64 {
+	return parseUserPath(l.User)
+}
+
+// UnmarshalJSON decodes the duration from the way Open Cloud encodes it (e.g., "3600s").
+func (l *UserRestrictionLog) UnmarshalJSON(data []byte) error {
+	type alias UserRestrictionLog
+
+	var raw struct {
+		*alias
+
+		Duration string `json:"duration"`
+	}
+
+	raw.alias = (*alias)(l)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	duration, err := ParseCloudDuration(raw.Duration)
+	if err != nil {
+		return err
+	}
+
+	l.Duration = duration
+
+	return nil
+}
+
+// UserRestrictionModerator represents who changed a restriction.
+// Exactly one of its fields is set.
+type UserRestrictionModerator struct {
+	RobloxUser       string    `json:"robloxUser,omitempty"`       // Path of the user who made the change (e.g., "users/123")
+	GameServerScript *struct{} `json:"gameServerScript,omitempty"` // Set if a script in a game server made the change
+}
+
+// UserID returns the ID of the user who made the change, or 0 if a script made it.
+func (m *UserRestrictionModerator) UserID() int64 {
+	return parseUserPath(m.RobloxUser)
+}
+
+// parseUserPath returns the ID of a user path (e.g., "users/123"), or 0 if it is invalid.
+func parseUserPath(path string) int64 {
+	id, err := strconv.ParseInt(strings.TrimPrefix(path, "users/"), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return id
+}
@@ -0,0 +1,43 @@
+// Package validation sets up the validator shared by every resource, including the
+// validations that are not built into it.
+package validation
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// New creates a new validator with every custom validation registered.
+func New() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	if err := Register(v); err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// Register adds the custom validations to an existing validator. Resources may use any of
+// them in their tags, so a validator passed to a resource must have them registered.
+//
+//   - maxbytes=N: the string is at most N bytes long, unlike max, which counts characters
+func Register(v *validator.Validate) error {
+	return v.RegisterValidation("maxbytes", maxBytes)
+}
+
+// maxBytes reports whether a string field is no longer than the byte count in its parameter.
+func maxBytes(fl validator.FieldLevel) bool {
+	limit, err := strconv.Atoi(fl.Param())
+	if err != nil {
+		panic("invalid maxbytes parameter: " + fl.Param())
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		panic("maxbytes only supports strings, got " + field.Kind().String())
+	}
+
+	return len(field.String()) <= limit
+}
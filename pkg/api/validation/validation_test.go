@@ -0,0 +1,35 @@
+package validation_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jaxron/roapi.go/pkg/api/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxBytes(t *testing.T) {
+	type params struct {
+		Message string `validate:"maxbytes=4"`
+	}
+
+	v := validation.New()
+
+	require.NoError(t, v.Struct(params{Message: "abcd"}))
+	require.NoError(t, v.Struct(params{Message: "éé"}))
+	require.Error(t, v.Struct(params{Message: "abcde"}))
+
+	// Three characters, but six bytes
+	err := v.Struct(params{Message: strings.Repeat("é", 3)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "maxbytes")
+}
+
+func TestRegister(t *testing.T) {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	require.NoError(t, validation.Register(v))
+	require.NoError(t, v.Var("abc", "maxbytes=3"))
+	require.Error(t, v.Var("abcd", "maxbytes=3"))
+}